@@ -0,0 +1,8 @@
+data "splight_asset_kind" "line" {
+  name = "Line"
+}
+
+# Attributes every Line asset must have
+output "required_line_attributes" {
+  value = [for attribute in data.splight_asset_kind.line.attributes : attribute.name if attribute.required]
+}
@@ -0,0 +1,45 @@
+resource "splight_grid" "my_grid" {
+  name = "My Grid"
+}
+
+resource "splight_generator" "my_generator" {
+  name = "My Generator"
+}
+
+resource "splight_inverter" "my_inverter" {
+  name = "My Inverter"
+}
+
+# Every relation connected to the grid, in any direction
+data "splight_asset_relations" "my_grid" {
+  asset = splight_grid.my_grid.id
+}
+
+locals {
+  relations = jsondecode(data.splight_asset_relations.my_grid.json).edges
+}
+
+check "inverters_related_to_generators" {
+  assert {
+    condition = alltrue([
+      for inverter in [splight_inverter.my_inverter] :
+      anytrue([for relation in local.relations : relation.asset == inverter.id && relation.related_asset_kind == "Generator"])
+    ])
+    error_message = "Every inverter must be related to a generator"
+  }
+}
+
+check "generators_related_to_grids" {
+  assert {
+    condition = alltrue([
+      for generator in [splight_generator.my_generator] :
+      anytrue([for relation in local.relations : relation.asset == generator.id && relation.related_asset_kind == "Grid"])
+    ])
+    error_message = "Every generator must be related to a grid"
+  }
+}
+
+# Render it with: terraform output -raw relations_dot | dot -Tsvg > relations.svg
+output "relations_dot" {
+  value = data.splight_asset_relations.my_grid.dot
+}
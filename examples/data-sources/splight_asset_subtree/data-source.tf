@@ -0,0 +1,25 @@
+resource "splight_substation" "my_substation" {
+  name = "My Substation"
+}
+
+resource "splight_bus" "my_bus" {
+  name   = "My Bus"
+  parent = splight_substation.my_substation.id
+}
+
+resource "splight_switch" "my_breaker" {
+  name     = "My Breaker"
+  parent   = splight_substation.my_substation.id
+  bus_from = splight_bus.my_bus.id
+}
+
+data "splight_asset_subtree" "my_substation" {
+  asset = splight_substation.my_substation.id
+
+  depends_on = [splight_bus.my_bus, splight_switch.my_breaker]
+}
+
+# Every asset in the substation, at any depth
+output "substation_assets" {
+  value = [for asset in data.splight_asset_subtree.my_substation.assets : asset.name]
+}
@@ -0,0 +1,19 @@
+# Every high voltage bus of the north region that reports its voltage
+data "splight_assets" "north_hv_buses" {
+  kind        = "Bus"
+  name_prefix = "North"
+  tags        = ["North", "HV"]
+  tags_match  = "all"
+  attributes  = ["voltage"]
+}
+
+# Lines named like "L-123", in any region
+data "splight_assets" "numbered_lines" {
+  kind       = "Line"
+  name_regex = "^L-\\d+$"
+}
+
+# Existing assets can be referenced by name rather than by id
+output "north_hv_bus_ids" {
+  value = { for asset in data.splight_assets.north_hv_buses.assets : asset.name => asset.id }
+}
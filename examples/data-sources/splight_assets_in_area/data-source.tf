@@ -0,0 +1,16 @@
+# Buses within a bounding box
+data "splight_assets_in_area" "downtown_buses" {
+  bbox  = [-58.42, -34.62, -58.36, -34.58]
+  kinds = ["Bus"]
+}
+
+# Assets within a service territory, drawn in a projected CRS
+data "splight_assets_in_area" "north_territory" {
+  area     = file("${path.module}/north_territory.geojson")
+  area_crs = "EPSG:22185"
+  tags     = ["Operated"]
+}
+
+output "north_territory_assets" {
+  value = { for asset in data.splight_assets_in_area.north_territory.assets : asset.id => asset.name }
+}
@@ -0,0 +1,15 @@
+terraform {
+  required_providers {
+    splight = {
+      source = "splightplatform/splight"
+    }
+  }
+}
+
+data "splight_conductor_type" "drake" {
+  name = "ACSR Drake"
+}
+
+output "drake_diameter" {
+  value = "${data.splight_conductor_type.drake.diameter} ${data.splight_conductor_type.drake.units["diameter"]}"
+}
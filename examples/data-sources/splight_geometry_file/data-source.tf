@@ -0,0 +1,35 @@
+terraform {
+  required_providers {
+    splight = {
+      source = "splightplatform/splight"
+    }
+  }
+}
+
+# Segments exported from GIS as a zipped shapefile in UTM zone 19S,
+# the reference system is read from its .prj
+data "splight_geometry_file" "segments" {
+  path         = "${path.module}/segments.zip"
+  key_property = "CODE"
+}
+
+resource "splight_segment" "segments" {
+  for_each = data.splight_geometry_file.segments.geometries
+
+  name     = "Segment ${each.key}"
+  geometry = each.value
+
+  description = jsondecode(data.splight_geometry_file.segments.properties[each.key]).DESCRIPTION
+}
+
+# Substations from a KML, keyed by Placemark name
+data "splight_geometry_file" "buses" {
+  path = "${path.module}/buses.kml"
+}
+
+resource "splight_bus" "buses" {
+  for_each = data.splight_geometry_file.buses.geometries
+
+  name     = each.key
+  geometry = each.value
+}
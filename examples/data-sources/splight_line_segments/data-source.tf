@@ -0,0 +1,43 @@
+terraform {
+  required_providers {
+    splight = {
+      source = "splightplatform/splight"
+    }
+  }
+}
+
+# One span per pair of consecutive towers. The third coordinate
+# of each position is the tower altitude in meters (optional).
+data "splight_line_segments" "my_line_segments" {
+  geometry = jsonencode({
+    type = "LineString"
+    coordinates = [
+      [-58.3816, -34.6037, 25],
+      [-58.3790, -34.6010, 27],
+      [-58.3751, -34.5988, 31]
+    ]
+  })
+}
+
+resource "splight_segment" "my_segments" {
+  for_each = { for segment in data.splight_line_segments.my_line_segments.segments : segment.index => segment }
+
+  name     = "My Segment ${each.key}"
+  geometry = each.value.geometry
+
+  altitude {
+    value = jsonencode(each.value.altitude)
+  }
+
+  azimuth {
+    value = jsonencode(each.value.azimuth)
+  }
+
+  cumulative_distance {
+    value = jsonencode(each.value.cumulative_distance)
+  }
+
+  span_length {
+    value = jsonencode(each.value.span_length)
+  }
+}
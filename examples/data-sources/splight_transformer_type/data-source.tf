@@ -0,0 +1,18 @@
+terraform {
+  required_providers {
+    splight = {
+      source = "splightplatform/splight"
+    }
+  }
+}
+
+data "splight_transformer_type" "distribution" {
+  name = "0.4 MVA 20/0.4 kV"
+}
+
+output "distribution_impedance" {
+  value = {
+    resistance = data.splight_transformer_type.distribution.resistance
+    reactance  = data.splight_transformer_type.distribution.reactance
+  }
+}
@@ -0,0 +1,16 @@
+terraform {
+  required_providers {
+    splight = {
+      source = "splightplatform/splight"
+    }
+  }
+}
+
+locals {
+  route = provider::splight::linestring([[-58.3816, -34.6037], [-58.4526, -34.6265]])
+}
+
+output "route_extent" {
+  # [west, south, east, north]
+  value = provider::splight::bbox(local.route)
+}
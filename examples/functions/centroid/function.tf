@@ -0,0 +1,18 @@
+terraform {
+  required_providers {
+    splight = {
+      source = "splightplatform/splight"
+    }
+  }
+}
+
+locals {
+  site = provider::splight::from_wkt("POLYGON ((-58.40 -34.60, -58.38 -34.60, -58.38 -34.62, -58.40 -34.62, -58.40 -34.60))")
+}
+
+resource "splight_generator" "my_generator" {
+  name = "My Generator"
+
+  # Place the generator at the center of its site
+  geometry = provider::splight::centroid(local.site)
+}
@@ -0,0 +1,14 @@
+terraform {
+  required_providers {
+    splight = {
+      source = "splightplatform/splight"
+    }
+  }
+}
+
+resource "splight_line" "my_line" {
+  name = "My Line"
+
+  # Route exported from Google Earth
+  geometry = provider::splight::from_kml(file("${path.module}/route.kml"))
+}
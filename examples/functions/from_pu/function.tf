@@ -0,0 +1,16 @@
+terraform {
+  required_providers {
+    splight = {
+      source = "splightplatform/splight"
+    }
+  }
+}
+
+resource "splight_transformer" "my_transformer" {
+  name = "My Transformer"
+
+  # Datasheet gives 0.0125 pu on the 40 MVA rating, referred to 110 kV
+  resistance {
+    number_value = provider::splight::from_pu(0.0125, "resistance", 110, 40)
+  }
+}
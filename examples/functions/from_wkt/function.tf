@@ -0,0 +1,12 @@
+terraform {
+  required_providers {
+    splight = {
+      source = "splightplatform/splight"
+    }
+  }
+}
+
+resource "splight_segment" "my_segment" {
+  name     = "My Segment"
+  geometry = provider::splight::from_wkt("LINESTRING (-58.3816 -34.6037, -58.4173 -34.6118)")
+}
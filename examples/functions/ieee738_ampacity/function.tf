@@ -0,0 +1,40 @@
+terraform {
+  required_providers {
+    splight = {
+      source = "splightplatform/splight"
+    }
+  }
+}
+
+locals {
+  # ACSR Drake
+  conductor = {
+    diameter                     = 0.02814
+    reference_resistance         = 0.0714
+    temperature_coeff_resistance = 0.00393
+    absorptivity                 = 0.8
+    emissivity                   = 0.8
+    maximum_allowed_temperature  = 100
+  }
+
+  # Conservative static rating assumptions
+  weather = {
+    ambient_temperature = 40
+    wind_speed          = 0.61
+    wind_direction      = 0
+    altitude            = 300
+    azimuth             = 90
+    latitude            = -34.6
+    day_of_year         = 355
+    solar_hour          = 12
+    clear_atmosphere    = true
+  }
+}
+
+resource "splight_line" "my_line" {
+  name = "My Line"
+
+  maximum_allowed_current {
+    number_value = floor(provider::splight::ieee738_ampacity(local.conductor, local.weather))
+  }
+}
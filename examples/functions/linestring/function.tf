@@ -0,0 +1,17 @@
+terraform {
+  required_providers {
+    splight = {
+      source = "splightplatform/splight"
+    }
+  }
+}
+
+resource "splight_line" "my_line" {
+  name = "My Line"
+
+  geometry = provider::splight::linestring([
+    [-58.3816, -34.6037],
+    [-58.4173, -34.6118],
+    [-58.4526, -34.6265],
+  ])
+}
@@ -0,0 +1,26 @@
+terraform {
+  required_providers {
+    splight = {
+      source = "splightplatform/splight"
+    }
+  }
+}
+
+locals {
+  length_km = 42.5
+
+  # Total resistance of the line, measured end to end
+  total_resistance = 3.03
+}
+
+resource "splight_line" "my_line" {
+  name = "My Line"
+
+  length {
+    number_value = local.length_km
+  }
+
+  reference_resistance {
+    number_value = provider::splight::per_km(local.total_resistance, local.length_km)
+  }
+}
@@ -0,0 +1,12 @@
+terraform {
+  required_providers {
+    splight = {
+      source = "splightplatform/splight"
+    }
+  }
+}
+
+resource "splight_bus" "my_bus" {
+  name     = "My Bus"
+  geometry = provider::splight::point(-58.3816, -34.6037)
+}
@@ -0,0 +1,26 @@
+terraform {
+  required_providers {
+    splight = {
+      source = "splightplatform/splight"
+    }
+  }
+}
+
+locals {
+  # Surveyed route in WGS 84 / UTM zone 19S
+  route_utm = jsonencode({
+    type        = "LineString"
+    coordinates = [[344846.72, 6297700.16], [352104.35, 6301872.48]]
+  })
+}
+
+output "route_wgs84" {
+  value = provider::splight::reproject(local.route_utm, "EPSG:32719")
+}
+
+# Alternatively, let the resource reproject its own geometry
+resource "splight_segment" "my_segment" {
+  name         = "My Segment"
+  geometry     = local.route_utm
+  geometry_crs = "EPSG:32719"
+}
@@ -0,0 +1,18 @@
+terraform {
+  required_providers {
+    splight = {
+      source = "splightplatform/splight"
+    }
+  }
+}
+
+locals {
+  # 132 kV line on a 100 MVA system base
+  base_kv  = 132
+  base_mva = 100
+}
+
+output "reactance_pu" {
+  # 21.8 Ω -> 0.1251 pu
+  value = provider::splight::to_pu(21.8, "reactance", local.base_kv, local.base_mva)
+}
@@ -55,4 +55,14 @@ resource "splight_asset" "my_asset" {
       }
     ]
   })
+
+  # The fields required by the kind must be declared, or created by the
+  # provider with create_kind_fields
+  create_kind_fields = true
+
+  extra_attribute {
+    name = "ampacity"
+    type = "Number"
+    unit = "A"
+  }
 }
@@ -0,0 +1,50 @@
+terraform {
+  required_providers {
+    splight = {
+      source = "splightplatform/splight"
+    }
+  }
+}
+
+resource "splight_asset" "my_asset" {
+  name        = "My Asset"
+  description = "My Asset Description"
+
+  geometry = jsonencode({
+    type = "GeometryCollection"
+    geometries = [
+      {
+        type        = "Point"
+        coordinates = [0, 0]
+      }
+    ]
+  })
+}
+
+locals {
+  sensors = ["temperature", "pressure", "humidity"]
+}
+
+# All the attributes are kept in one resource, only the ones that change
+# are created, updated or deleted
+resource "splight_asset_attributes" "my_attributes" {
+  asset = splight_asset.my_asset.id
+
+  dynamic "attribute" {
+    for_each = local.sensors
+
+    content {
+      name = attribute.value
+      type = "Number"
+    }
+  }
+
+  attribute {
+    name = "Status"
+    type = "String"
+  }
+}
+
+output "temperature_attribute_id" {
+  value = splight_asset_attributes.my_attributes.ids["temperature"]
+}
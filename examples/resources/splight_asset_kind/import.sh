@@ -0,0 +1 @@
+terraform import [options] splight_asset_kind.<name> <asset_kind_id>
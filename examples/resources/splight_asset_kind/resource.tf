@@ -0,0 +1,53 @@
+terraform {
+  required_providers {
+    splight = {
+      source = "splightplatform/splight"
+    }
+  }
+}
+
+resource "splight_asset_kind" "weather_station" {
+  name        = "Weather Station"
+  description = "Meteorological station next to a line"
+  icon        = "cloud"
+
+  attributes {
+    name     = "temperature"
+    type     = "Number"
+    unit     = "°C"
+    required = true
+  }
+
+  attributes {
+    name = "wind_speed"
+    type = "Number"
+    unit = "m/s"
+  }
+
+  metadata {
+    name     = "height"
+    type     = "Number"
+    unit     = "m"
+    required = true
+    value    = jsonencode(10)
+  }
+}
+
+# Assets of a Terraform managed kind
+resource "splight_asset" "my_station" {
+  name = "My Station"
+
+  kind {
+    id   = splight_asset_kind.weather_station.id
+    name = splight_asset_kind.weather_station.name
+  }
+
+  extra_attribute {
+    name = "temperature"
+    type = "Number"
+    unit = "°C"
+  }
+
+  # The height metadata is created with its default value
+  create_kind_fields = true
+}
@@ -23,9 +23,17 @@ resource "splight_asset" "my_asset" {
 }
 
 resource "splight_asset_metadata" "my_asset_metadata" {
-  name  = "My Asset Metadata"
-  type  = "Number"
-  unit  = "meters"
-  value = jsonencode(10)
+  name         = "My Asset Metadata"
+  type         = "Number"
+  unit         = "meters"
+  number_value = 10
+  asset        = splight_asset.my_asset.id
+}
+
+# Values may also be given JSON encoded, for any type
+resource "splight_asset_metadata" "my_json_asset_metadata" {
+  name  = "My JSON Asset Metadata"
+  type  = "String"
+  value = jsonencode("ACSR Drake")
   asset = splight_asset.my_asset.id
 }
@@ -0,0 +1,47 @@
+terraform {
+  required_providers {
+    splight = {
+      source = "splightplatform/splight"
+    }
+  }
+}
+
+resource "splight_asset" "my_asset" {
+  name        = "My Asset"
+  description = "My Asset Description"
+
+  geometry = jsonencode({
+    type = "GeometryCollection"
+    geometries = [
+      {
+        type        = "Point"
+        coordinates = [0, 0]
+      }
+    ]
+  })
+}
+
+# All the metadata are kept in one resource, only the ones that change
+# are created, updated or deleted
+resource "splight_asset_metadata_set" "my_metadata" {
+  asset = splight_asset.my_asset.id
+
+  metadata {
+    name         = "Rated Power"
+    type         = "Number"
+    number_value = 150
+    unit         = "MW"
+  }
+
+  metadata {
+    name     = "Height"
+    type     = "Number"
+    quantity = "120 m"
+  }
+
+  metadata {
+    name         = "Manufacturer"
+    type         = "String"
+    string_value = "ACME"
+  }
+}
@@ -0,0 +1 @@
+terraform import [options] splight_battery.<name> <battery_id>
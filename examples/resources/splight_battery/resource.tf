@@ -0,0 +1,61 @@
+terraform {
+  required_providers {
+    splight = {
+      source = "splightplatform/splight"
+    }
+  }
+}
+
+resource "splight_grid" "my_grid" {
+  name = "My Grid"
+}
+
+resource "splight_bus" "my_bus" {
+  name = "My Bus"
+}
+
+resource "splight_battery" "my_battery" {
+  name        = "My Battery"
+  description = "My Battery Description"
+
+  # This overrides the timezone computed from the geolocation
+  custom_timezone = "America/Los_Angeles"
+
+  geometry = jsonencode({
+    type = "GeometryCollection"
+    geometries = [
+      {
+        type        = "Point"
+        coordinates = [0, 0]
+      }
+    ]
+  })
+
+  bus  = splight_bus.my_bus.id
+  grid = splight_grid.my_grid.id
+
+  # You may ommit some keys to use the default values from the API
+  rated_energy {
+    quantity = "200 MWh"
+  }
+
+  rated_power {
+    number_value = 50
+  }
+
+  round_trip_efficiency {
+    quantity = "90 %"
+  }
+
+  min_soc {
+    number_value = 10
+  }
+
+  max_soc {
+    number_value = 95
+  }
+}
+
+output "state_of_charge_attribute_id" {
+  value = splight_battery.my_battery.attributes["state_of_charge"]
+}
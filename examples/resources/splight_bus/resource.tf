@@ -51,4 +51,27 @@ resource "splight_bus" "my_bus" {
   nominal_voltage_kv {
     value = jsonencode(2.2)
   }
+
+  # Extra attributes and metadata are managed along with the bus
+  extra_attribute {
+    name = "frequency"
+    type = "Number"
+    unit = "Hz"
+  }
+
+  extra_metadata {
+    name     = "busbar_length"
+    type     = "Number"
+    quantity = "120 m"
+  }
+
+  extra_metadata {
+    name         = "operator"
+    type         = "String"
+    string_value = "ACME"
+  }
+}
+
+output "frequency_attribute_id" {
+  value = splight_bus.my_bus.attributes["frequency"]
 }
@@ -136,3 +136,51 @@ resource "splight_line" "my_line" {
     value = jsonencode(1.1)
   }
 }
+
+# Conductor metadata can be filled from a standard conductor type
+resource "splight_line" "my_drake_line" {
+  name = "My Drake Line"
+
+  # Fills diameter, reference_resistance, conductor_mass, specific_heat,
+  # thermal_elongation_coef and temperature_coeff_resistance
+  conductor_type = "ACSR Drake"
+
+  # Explicit blocks take precedence over the conductor type.
+  # Quantities are converted to the unit the platform expects (m)
+  diameter {
+    quantity = "28.2 mm"
+  }
+}
+
+# The length metadata can be computed from the line geometry
+resource "splight_line" "my_line_from_geometry" {
+  name = "My Line From Geometry"
+
+  # Fills the length metadata with the geodesic length in km.
+  # An explicit length block would take precedence, and a warning
+  # is shown if it differs from the geometry by more than 1%.
+  length_from_geometry = true
+
+  geometry = jsonencode({
+    type = "GeometryCollection"
+    geometries = [
+      {
+        type = "LineString"
+        coordinates = [
+          [-58.3816, -34.6037],
+          [-58.3790, -34.6010],
+          [-58.3751, -34.5988]
+        ]
+      }
+    ]
+  })
+}
+
+# Nested attributes and metadata can be referenced by name
+output "my_line_ampacity_attribute" {
+  value = splight_line.my_line.attributes["ampacity"]
+}
+
+output "my_line_length_metadata" {
+  value = splight_line.my_line.metadata["length"]
+}
@@ -0,0 +1 @@
+terraform import [options] splight_load.<name> <load_id>
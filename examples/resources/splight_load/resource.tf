@@ -0,0 +1,37 @@
+terraform {
+  required_providers {
+    splight = {
+      source = "splightplatform/splight"
+    }
+  }
+}
+
+resource "splight_bus" "my_bus" {
+  name = "My Bus"
+}
+
+resource "splight_load" "my_load" {
+  name        = "My Load"
+  description = "My Load Description"
+
+  geometry = jsonencode({
+    type = "GeometryCollection"
+    geometries = [
+      {
+        type        = "Point"
+        coordinates = [0, 0]
+      }
+    ]
+  })
+
+  bus = splight_bus.my_bus.id
+
+  # You may ommit some keys to use the default values from the API
+  rated_active_power {
+    quantity = "12 MW"
+  }
+
+  rated_reactive_power {
+    quantity = "3500 kvar"
+  }
+}
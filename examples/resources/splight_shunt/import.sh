@@ -0,0 +1 @@
+terraform import [options] splight_shunt.<name> <shunt_id>
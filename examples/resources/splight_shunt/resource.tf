@@ -0,0 +1,37 @@
+terraform {
+  required_providers {
+    splight = {
+      source = "splightplatform/splight"
+    }
+  }
+}
+
+resource "splight_bus" "my_bus" {
+  name = "My Bus"
+}
+
+resource "splight_shunt" "my_capacitor_bank" {
+  name        = "My Capacitor Bank"
+  description = "My Capacitor Bank Description"
+
+  geometry = jsonencode({
+    type = "GeometryCollection"
+    geometries = [
+      {
+        type        = "Point"
+        coordinates = [0, 0]
+      }
+    ]
+  })
+
+  bus = splight_bus.my_bus.id
+
+  # Reactors are rated with a negative value
+  rated_reactive_power {
+    quantity = "20 Mvar"
+  }
+
+  max_step {
+    number_value = 4
+  }
+}
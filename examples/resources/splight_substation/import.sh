@@ -0,0 +1 @@
+terraform import [options] splight_substation.<name> <substation_id>
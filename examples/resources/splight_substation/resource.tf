@@ -0,0 +1,26 @@
+terraform {
+  required_providers {
+    splight = {
+      source = "splightplatform/splight"
+    }
+  }
+}
+
+resource "splight_substation" "my_substation" {
+  name        = "My Substation"
+  description = "My Substation Description"
+
+  # The area of the substation, only polygons are allowed
+  geometry = jsonencode({
+    type = "Polygon"
+    coordinates = [
+      [
+        [-58.3816, -34.6037],
+        [-58.3806, -34.6037],
+        [-58.3806, -34.6029],
+        [-58.3816, -34.6029],
+        [-58.3816, -34.6037]
+      ]
+    ]
+  })
+}
@@ -0,0 +1 @@
+terraform import [options] splight_switch.<name> <switch_id>
@@ -0,0 +1,34 @@
+terraform {
+  required_providers {
+    splight = {
+      source = "splightplatform/splight"
+    }
+  }
+}
+
+resource "splight_bus" "my_bus_a" {
+  name = "My Bus A"
+}
+
+resource "splight_bus" "my_bus_b" {
+  name = "My Bus B"
+}
+
+resource "splight_substation" "my_substation" {
+  name = "My Substation"
+}
+
+resource "splight_switch" "my_breaker" {
+  name        = "My Breaker"
+  description = "My Breaker Description"
+
+  # Place the breaker under its substation
+  parent = splight_substation.my_substation.id
+
+  bus_from = splight_bus.my_bus_a.id
+  bus_to   = splight_bus.my_bus_b.id
+
+  normally_open {
+    bool_value = false
+  }
+}
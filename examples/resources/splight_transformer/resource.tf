@@ -84,3 +84,20 @@ resource "splight_transformer" "my_transformer" {
     value = jsonencode(5)
   }
 }
+
+# Electrical parameters can be derived from a standard type
+resource "splight_transformer" "my_standard_transformer" {
+  name = "My Standard Transformer"
+
+  # Fills resistance, reactance, conductance and maximum_allowed_power
+  # from the splight_transformer_type catalog
+  standard_type {
+    string_value = "0.4 MVA 20/0.4 kV"
+  }
+
+  # Explicit blocks take precedence over the standard type,
+  # and a warning is shown when they differ from it
+  maximum_allowed_power {
+    number_value = 0.4
+  }
+}
@@ -8,9 +8,11 @@ import (
 	"flag"
 	"log"
 
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
 	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
 	"github.com/hashicorp/terraform-plugin-go/tfprotov6/tf6server"
 	"github.com/hashicorp/terraform-plugin-mux/tf5to6server"
+	"github.com/hashicorp/terraform-plugin-mux/tf6muxserver"
 	"github.com/splightplatform/terraform-provider-splight/provider"
 )
 
@@ -30,8 +32,18 @@ func main() {
 		log.Fatalf("Failed to upgrade provider server: %v", err)
 	}
 
+	// Mux the SDK provider with the framework one, which serves the provider functions.
+	muxServer, err := tf6muxserver.NewMuxServer(
+		context.Background(),
+		func() tfprotov6.ProviderServer { return upgradedSdkProvider },
+		providerserver.NewProtocol6(provider.NewFrameworkProvider()),
+	)
+	if err != nil {
+		log.Fatalf("Failed to create mux server: %v", err)
+	}
+
 	// Serve the provider with or without debugging based on the flag.
-	if err := serveProvider(upgradedSdkProvider, debug); err != nil {
+	if err := serveProvider(muxServer.ProviderServer(), debug); err != nil {
 		log.Fatalf("Failed to serve provider: %v", err)
 	}
 }
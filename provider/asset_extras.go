@@ -0,0 +1,118 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// CustomizeExtras plans the attributes and metadata maps of a typed asset
+// as unknown when its extra, or kind, attributes and metadata change, since
+// the ids of the new ones are only known once they're created
+func CustomizeExtras(ctx context.Context, d *schema.ResourceDiff, meta any) error {
+	if d.Id() == "" {
+		return nil
+	}
+
+	if d.HasChange("extra_attribute") || d.HasChange("kind_attribute") {
+		for _, key := range []string{"attributes", "attribute_types", "attribute_units"} {
+			if err := d.SetNewComputed(key); err != nil {
+				return err
+			}
+		}
+	}
+
+	if d.HasChange("extra_metadata") || d.HasChange("kind_metadata") {
+		for _, key := range []string{"metadata", "metadata_types", "metadata_units"} {
+			if err := d.SetNewComputed(key); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// CustomizeAssetCollection plans the ids of a collection of attributes or
+// metadata when its items change. Items are matched by name, as they're
+// saved, so the ones that move to another position keep their ids and the
+// ones to be created, or created again, are planned as unknown.
+func CustomizeAssetCollection(block string, recreatedBy ...string) schema.CustomizeDiffFunc {
+	return func(ctx context.Context, d *schema.ResourceDiff, meta any) error {
+		if d.Id() == "" || !d.HasChange(block) {
+			return nil
+		}
+
+		if err := d.SetNewComputed("ids"); err != nil {
+			return err
+		}
+
+		// Items are planned again once they're known. Without the raw
+		// configuration the merged values are used as they are.
+		if config := d.GetRawConfig(); !config.IsNull() && !config.GetAttr(block).IsWhollyKnown() {
+			return nil
+		}
+
+		old, planned := d.GetChange(block)
+		previous := map[string]map[string]any{}
+		for _, item := range old.([]any) {
+			if values, ok := item.(map[string]any); ok {
+				previous[values["name"].(string)] = values
+			}
+		}
+
+		var items []any
+		for _, item := range planned.([]any) {
+			values := map[string]any{}
+			for key, value := range item.(map[string]any) {
+				values[key] = value
+			}
+
+			// An empty id is planned as unknown
+			values["id"] = ""
+			if old, ok := previous[values["name"].(string)]; ok && sameFields(old, values, recreatedBy) {
+				values["id"] = old["id"]
+			}
+			items = append(items, values)
+		}
+
+		return d.SetNew(block, items)
+	}
+}
+
+// sameFields reports whether the given fields of two items are equal
+func sameFields(a, b map[string]any, keys []string) bool {
+	for _, key := range keys {
+		if a[key] != b[key] {
+			return false
+		}
+	}
+	return true
+}
+
+// ValidateAssetCollection requires at least one item in the block of a
+// collection. It's optional to Terraform since it's computed, so the ids of
+// its items can be planned.
+func ValidateAssetCollection(block string) schema.ValidateRawResourceConfigFunc {
+	return func(ctx context.Context, req schema.ValidateResourceConfigFuncRequest, resp *schema.ValidateResourceConfigFuncResponse) {
+		config := req.RawConfig
+		if config.IsNull() || !config.IsKnown() {
+			return
+		}
+
+		value := config.GetAttr(block)
+		if !value.IsKnown() {
+			return
+		}
+		if value.IsNull() || value.LengthInt() == 0 {
+			resp.Diagnostics = append(resp.Diagnostics, diag.Diagnostic{
+				Severity:      diag.Error,
+				Summary:       "Missing " + block + " blocks",
+				Detail:        "At least one " + block + " block is required.",
+				AttributePath: cty.GetAttrPath(block),
+			})
+		}
+	}
+}
@@ -0,0 +1,229 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/splightplatform/terraform-provider-splight/splight/client"
+	"github.com/splightplatform/terraform-provider-splight/splight/client/models"
+)
+
+func dataSourceAssetKind(schemaFunc func() map[string]*schema.Schema) *schema.Resource {
+	return &schema.Resource{
+		Schema:      schemaFunc(),
+		ReadContext: RetrieveAssetKind,
+	}
+}
+
+// RetrieveAssetKind reads the definition of an asset kind by id or name
+func RetrieveAssetKind(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	apiClient := meta.(*client.Client)
+
+	kind, err := apiClient.RetrieveAssetKind(d.Get("id").(string), d.Get("name").(string))
+	if err != nil {
+		return diag.Errorf("error reading asset kind: %s", err.Error())
+	}
+
+	if err := kind.ToSchema(d); err != nil {
+		return diag.Errorf("error mapping model to schema: %s", err.Error())
+	}
+
+	return nil
+}
+
+// declaredFields returns the items of a block by name, or false if any of
+// them isn't known yet
+func declaredFields(d *schema.ResourceDiff, block string) (map[string]map[string]any, bool) {
+	if !d.NewValueKnown(block) {
+		return nil, false
+	}
+
+	fields := map[string]map[string]any{}
+	for _, item := range d.Get(block).([]any) {
+		values, ok := item.(map[string]any)
+		if !ok {
+			continue
+		}
+		name, _ := values["name"].(string)
+		if name == "" {
+			// The name is only known at apply
+			return nil, false
+		}
+		fields[name] = values
+	}
+	return fields, true
+}
+
+// previousFieldIds returns the ids of the items of the given blocks in the state by name
+func previousFieldIds(d *schema.ResourceDiff, blocks ...string) map[string]map[string]any {
+	previous := map[string]map[string]any{}
+	for _, block := range blocks {
+		old, _ := d.GetChange(block)
+		for _, item := range old.([]any) {
+			if values, ok := item.(map[string]any); ok && values["id"] != "" {
+				previous[values["name"].(string)] = values
+			}
+		}
+	}
+	return previous
+}
+
+// missingKindFields checks the declared fields against the ones a kind
+// expects, returning the required ones that weren't declared
+func missingKindFields(fieldType string, expected []models.AssetKindField, declared map[string]map[string]any) ([]models.AssetKindField, error) {
+	var missing []models.AssetKindField
+	for _, field := range expected {
+		values, ok := declared[field.Name]
+		if !ok {
+			if field.Required {
+				missing = append(missing, field)
+			}
+			continue
+		}
+
+		if declaredType, _ := values["type"].(string); declaredType != field.Type {
+			return nil, fmt.Errorf("%s %q must be of type %s, got %s", fieldType, field.Name, field.Type, declaredType)
+		}
+	}
+	return missing, nil
+}
+
+func fieldNames(fields []models.AssetKindField) string {
+	names := make([]string, len(fields))
+	for i, field := range fields {
+		names[i] = field.Name
+	}
+	sort.Strings(names)
+	return strings.Join(names, ", ")
+}
+
+// CustomizeKindFields checks at plan time that the attributes and metadata
+// the kind of an asset requires are declared. With create_kind_fields set,
+// the missing ones are planned to be created along with the asset instead.
+func CustomizeKindFields(ctx context.Context, d *schema.ResourceDiff, meta any) error {
+	apiClient, ok := meta.(*client.Client)
+	if !ok || !d.NewValueKnown("kind") {
+		return nil
+	}
+
+	kinds := d.Get("kind").(*schema.Set).List()
+	if len(kinds) == 0 {
+		return clearKindFields(d)
+	}
+	kindValues, _ := kinds[0].(map[string]any)
+	kindId, _ := kindValues["id"].(string)
+	kindName, _ := kindValues["name"].(string)
+	if kindId == "" && kindName == "" {
+		return nil
+	}
+
+	attributes, attributesKnown := declaredFields(d, "extra_attribute")
+	metadata, metadataKnown := declaredFields(d, "extra_metadata")
+	if !attributesKnown || !metadataKnown {
+		// Checked once the declared fields are known
+		return nil
+	}
+
+	kind, err := apiClient.RetrieveAssetKind(kindId, kindName)
+	if err != nil {
+		return fmt.Errorf("error reading asset kind %s: %w", kindName, err)
+	}
+
+	missingAttributes, err := missingKindFields("attribute", kind.Attributes, attributes)
+	if err != nil {
+		return fmt.Errorf("kind %s: %w", kind.Name, err)
+	}
+	missingMetadata, err := missingKindFields("metadata", kind.Metadata, metadata)
+	if err != nil {
+		return fmt.Errorf("kind %s: %w", kind.Name, err)
+	}
+
+	if !d.Get("create_kind_fields").(bool) {
+		var problems []string
+		if len(missingAttributes) > 0 {
+			problems = append(problems, fmt.Sprintf("attributes %s must be declared as extra_attribute blocks", fieldNames(missingAttributes)))
+		}
+		if len(missingMetadata) > 0 {
+			problems = append(problems, fmt.Sprintf("metadata %s must be declared as extra_metadata blocks", fieldNames(missingMetadata)))
+		}
+		if len(problems) > 0 {
+			return fmt.Errorf("kind %s requires fields that aren't declared: %s. Set create_kind_fields to create them", kind.Name, strings.Join(problems, "; "))
+		}
+		return clearKindFields(d)
+	}
+
+	// Fields already created keep their ids, even if they were declared before
+	previous := previousFieldIds(d, "kind_attribute", "extra_attribute")
+	kindAttributes := make([]map[string]any, len(missingAttributes))
+	for i, field := range missingAttributes {
+		var id string
+		if old, ok := previous[field.Name]; ok && old["unit"] == field.Unit {
+			id = old["id"].(string)
+		}
+		kindAttributes[i] = map[string]any{
+			"id":   id,
+			"name": field.Name,
+			"type": field.Type,
+			"unit": field.Unit,
+		}
+	}
+
+	previous = previousFieldIds(d, "kind_metadata", "extra_metadata")
+	kindMetadata := make([]map[string]any, len(missingMetadata))
+	for i, field := range missingMetadata {
+		var id string
+		if old, ok := previous[field.Name]; ok {
+			id = old["id"].(string)
+		}
+		value := "null"
+		if len(field.Value) > 0 {
+			value = string(field.Value)
+		}
+		kindMetadata[i] = map[string]any{
+			"id":    id,
+			"name":  field.Name,
+			"type":  field.Type,
+			"unit":  field.Unit,
+			"value": value,
+		}
+	}
+
+	if err := setKindFields(d, "kind_attribute", kindAttributes); err != nil {
+		return err
+	}
+	return setKindFields(d, "kind_metadata", kindMetadata)
+}
+
+// setKindFields plans the fields created for the kind, only if they change
+func setKindFields(d *schema.ResourceDiff, block string, fields []map[string]any) error {
+	old, _ := d.GetChange(block)
+	current := old.([]any)
+
+	changed := len(current) != len(fields)
+	for i := 0; !changed && i < len(fields); i++ {
+		values, _ := current[i].(map[string]any)
+		for key, value := range fields[i] {
+			if values[key] != value {
+				changed = true
+				break
+			}
+		}
+	}
+	if !changed {
+		return nil
+	}
+
+	return d.SetNew(block, fields)
+}
+
+// clearKindFields plans the deletion of the fields created for the kind
+func clearKindFields(d *schema.ResourceDiff) error {
+	if err := setKindFields(d, "kind_attribute", nil); err != nil {
+		return err
+	}
+	return setKindFields(d, "kind_metadata", nil)
+}
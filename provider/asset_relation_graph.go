@@ -0,0 +1,37 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/splightplatform/terraform-provider-splight/splight/client"
+	"github.com/splightplatform/terraform-provider-splight/splight/client/models"
+)
+
+func dataSourceAssetRelationGraph(schemaFunc func() map[string]*schema.Schema) *schema.Resource {
+	return &schema.Resource{
+		Schema:      schemaFunc(),
+		ReadContext: RetrieveAssetRelationGraph,
+	}
+}
+
+// RetrieveAssetRelationGraph reads every relation reachable from an asset
+func RetrieveAssetRelationGraph(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	apiClient := meta.(*client.Client)
+
+	graph := &models.AssetRelationGraph{}
+	if err := graph.FromSchema(d); err != nil {
+		return diag.Errorf("error mapping schema to model: %s", err.Error())
+	}
+
+	if err := apiClient.RetrieveAssetRelationGraph(graph); err != nil {
+		return diag.Errorf("error reading asset relations: %s", err.Error())
+	}
+
+	if err := graph.ToSchema(d); err != nil {
+		return diag.Errorf("error mapping model to schema: %s", err.Error())
+	}
+
+	return nil
+}
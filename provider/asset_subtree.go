@@ -0,0 +1,37 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/splightplatform/terraform-provider-splight/splight/client"
+	"github.com/splightplatform/terraform-provider-splight/splight/client/models"
+)
+
+func dataSourceAssetSubtree(schemaFunc func() map[string]*schema.Schema) *schema.Resource {
+	return &schema.Resource{
+		Schema:      schemaFunc(),
+		ReadContext: RetrieveAssetSubtree,
+	}
+}
+
+// RetrieveAssetSubtree reads every asset under an asset in the hierarchy
+func RetrieveAssetSubtree(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	apiClient := meta.(*client.Client)
+
+	subtree := &models.AssetSubtree{}
+	if err := subtree.FromSchema(d); err != nil {
+		return diag.Errorf("error mapping schema to model: %s", err.Error())
+	}
+
+	if err := apiClient.RetrieveAssetSubtree(subtree); err != nil {
+		return diag.Errorf("error reading asset subtree: %s", err.Error())
+	}
+
+	if err := subtree.ToSchema(d); err != nil {
+		return diag.Errorf("error mapping model to schema: %s", err.Error())
+	}
+
+	return nil
+}
@@ -0,0 +1,50 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/splightplatform/terraform-provider-splight/splight/client"
+	"github.com/splightplatform/terraform-provider-splight/splight/client/models"
+)
+
+func dataSourceAssets(schemaFunc func() map[string]*schema.Schema) *schema.Resource {
+	return &schema.Resource{
+		Schema:      schemaFunc(),
+		ReadContext: SearchAssets,
+	}
+}
+
+// SearchAssets reads the assets that match the filters
+func SearchAssets(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	apiClient := meta.(*client.Client)
+
+	assets := &models.Assets{}
+	if err := assets.FromSchema(d); err != nil {
+		return diag.Errorf("error mapping schema to model: %s", err.Error())
+	}
+
+	if err := client.List(apiClient, assets); err != nil {
+		return diag.Errorf("error listing assets: %s", err.Error())
+	}
+	assets.Filter()
+
+	// Attributes are only looked up while there are assets left
+	for _, name := range assets.Attributes {
+		if len(assets.Assets) == 0 {
+			break
+		}
+		withAttribute, err := apiClient.ListAttributeAssets(name)
+		if err != nil {
+			return diag.Errorf("error listing attributes named %s: %s", name, err.Error())
+		}
+		assets.FilterByAttribute(withAttribute)
+	}
+
+	if err := assets.ToSchema(d); err != nil {
+		return diag.Errorf("error mapping model to schema: %s", err.Error())
+	}
+
+	return nil
+}
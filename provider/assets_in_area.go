@@ -0,0 +1,40 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/splightplatform/terraform-provider-splight/splight/client"
+	"github.com/splightplatform/terraform-provider-splight/splight/client/models"
+)
+
+func dataSourceAssetsInArea(schemaFunc func() map[string]*schema.Schema) *schema.Resource {
+	return &schema.Resource{
+		Schema:      schemaFunc(),
+		ReadContext: ListAssetsInArea,
+	}
+}
+
+// ListAssetsInArea reads the assets whose geometry intersects an area
+func ListAssetsInArea(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	apiClient := meta.(*client.Client)
+
+	assets := &models.AssetsInArea{}
+	if err := assets.FromSchema(d); err != nil {
+		return diag.Errorf("error mapping schema to model: %s", err.Error())
+	}
+
+	if err := client.List(apiClient, assets); err != nil {
+		return diag.Errorf("error listing assets: %s", err.Error())
+	}
+
+	// Assets whose geometry can't be read are skipped with a warning
+	diags := assets.Filter()
+
+	if err := assets.ToSchema(d); err != nil {
+		return append(diags, diag.Errorf("error mapping model to schema: %s", err.Error())...)
+	}
+
+	return diags
+}
@@ -0,0 +1,72 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	fwprovider "github.com/hashicorp/terraform-plugin-framework/provider"
+	fwschema "github.com/hashicorp/terraform-plugin-framework/provider/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/splightplatform/terraform-provider-splight/provider/functions"
+)
+
+// FrameworkProvider serves the provider functions, which the SDK can't
+// define. It is muxed with the SDK provider, which owns every resource and
+// data source, so both must share the same provider schema.
+type FrameworkProvider struct {
+	version string
+}
+
+var _ fwprovider.ProviderWithFunctions = &FrameworkProvider{}
+
+func NewFrameworkProvider() fwprovider.Provider {
+	return &FrameworkProvider{version: Version}
+}
+
+func (p *FrameworkProvider) Metadata(ctx context.Context, req fwprovider.MetadataRequest, resp *fwprovider.MetadataResponse) {
+	resp.TypeName = "splight"
+	resp.Version = p.version
+}
+
+func (p *FrameworkProvider) Schema(ctx context.Context, req fwprovider.SchemaRequest, resp *fwprovider.SchemaResponse) {
+	resp.Schema = fwschema.Schema{
+		Attributes: map[string]fwschema.Attribute{
+			"hostname": fwschema.StringAttribute{
+				Optional: true,
+			},
+			"token": fwschema.StringAttribute{
+				Optional:  true,
+				Sensitive: true,
+			},
+		},
+	}
+}
+
+// Configure is a no-op: functions are pure and never call the API
+func (p *FrameworkProvider) Configure(ctx context.Context, req fwprovider.ConfigureRequest, resp *fwprovider.ConfigureResponse) {
+}
+
+func (p *FrameworkProvider) Resources(ctx context.Context) []func() resource.Resource {
+	return nil
+}
+
+func (p *FrameworkProvider) DataSources(ctx context.Context) []func() datasource.DataSource {
+	return nil
+}
+
+func (p *FrameworkProvider) Functions(ctx context.Context) []func() function.Function {
+	return []func() function.Function{
+		functions.NewIeee738AmpacityFunction,
+		functions.NewToPuFunction,
+		functions.NewFromPuFunction,
+		functions.NewPerKmFunction,
+		functions.NewPointFunction,
+		functions.NewLinestringFunction,
+		functions.NewFromWktFunction,
+		functions.NewFromKmlFunction,
+		functions.NewCentroidFunction,
+		functions.NewBboxFunction,
+		functions.NewReprojectFunction,
+	}
+}
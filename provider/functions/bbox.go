@@ -0,0 +1,58 @@
+package functions
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type BboxFunction struct{}
+
+var _ function.Function = &BboxFunction{}
+
+func NewBboxFunction() function.Function {
+	return &BboxFunction{}
+}
+
+func (f *BboxFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "bbox"
+}
+
+func (f *BboxFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Bounding box of a geometry",
+		Description: "Returns the extent of a GeoJSON geometry as [west, south, east, north] in degrees, " +
+			"the order of the RFC 7946 bbox member.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "geojson",
+				Description: "JSON encoded GeoJSON geometry",
+			},
+		},
+		Return: function.ListReturn{ElementType: types.Float64Type},
+	}
+}
+
+func (f *BboxFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var geojson string
+
+	resp.Error = req.Arguments.Get(ctx, &geojson)
+	if resp.Error != nil {
+		return
+	}
+
+	geometry, funcErr := parseGeometryArgument(0, geojson)
+	if funcErr != nil {
+		resp.Error = funcErr
+		return
+	}
+
+	bbox, err := geometry.BoundingBox()
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(0, err.Error())
+		return
+	}
+
+	resp.Error = resp.Result.Set(ctx, bbox[:])
+}
@@ -0,0 +1,65 @@
+package functions
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/splightplatform/terraform-provider-splight/splight/geo"
+)
+
+type CentroidFunction struct{}
+
+var _ function.Function = &CentroidFunction{}
+
+func NewCentroidFunction() function.Function {
+	return &CentroidFunction{}
+}
+
+func (f *CentroidFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "centroid"
+}
+
+func (f *CentroidFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Center of a geometry",
+		Description: "Returns the centroid of a GeoJSON geometry as a GeometryCollection with a single Point, in the " +
+			"canonical form the platform stores. Only the parts with the highest dimension count: polygons are weighted " +
+			"by area and lines by length. It is computed on longitude and latitude as planar coordinates.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "geojson",
+				Description: "JSON encoded GeoJSON geometry",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *CentroidFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var geojson string
+
+	resp.Error = req.Arguments.Get(ctx, &geojson)
+	if resp.Error != nil {
+		return
+	}
+
+	geometry, funcErr := parseGeometryArgument(0, geojson)
+	if funcErr != nil {
+		resp.Error = funcErr
+		return
+	}
+
+	centroid, err := geometry.Centroid()
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(0, err.Error())
+		return
+	}
+
+	point, funcErr := canonicalGeometry(geo.NewGeometryCollection(geo.NewPoint(centroid)))
+	if funcErr != nil {
+		resp.Error = funcErr
+		return
+	}
+
+	resp.Error = resp.Result.Set(ctx, point)
+}
@@ -0,0 +1,58 @@
+package functions
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/splightplatform/terraform-provider-splight/splight/geo"
+)
+
+type FromKmlFunction struct{}
+
+var _ function.Function = &FromKmlFunction{}
+
+func NewFromKmlFunction() function.Function {
+	return &FromKmlFunction{}
+}
+
+func (f *FromKmlFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "from_kml"
+}
+
+func (f *FromKmlFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Convert KML geometries to GeoJSON",
+		Description: "Converts the Point, LineString and Polygon geometries of a KML document to a GeoJSON GeometryCollection " +
+			"in the canonical form the platform stores. Geometries in Folders, Placemarks and MultiGeometries are all collected.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "kml",
+				Description: "KML document, e.g. read with file()",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *FromKmlFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var input string
+
+	resp.Error = req.Arguments.Get(ctx, &input)
+	if resp.Error != nil {
+		return
+	}
+
+	parsed, err := geo.ParseKML(input)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(0, err.Error())
+		return
+	}
+
+	geometry, funcErr := canonicalGeometry(parsed)
+	if funcErr != nil {
+		resp.Error = funcErr
+		return
+	}
+
+	resp.Error = resp.Result.Set(ctx, geometry)
+}
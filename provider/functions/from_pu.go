@@ -0,0 +1,68 @@
+package functions
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/splightplatform/terraform-provider-splight/splight/electrical"
+)
+
+type FromPuFunction struct{}
+
+var _ function.Function = &FromPuFunction{}
+
+func NewFromPuFunction() function.Function {
+	return &FromPuFunction{}
+}
+
+func (f *FromPuFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "from_pu"
+}
+
+func (f *FromPuFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Convert a per-unit value to its ohmic value",
+		Description: "Returns the per-unit value multiplied by the base of its quantity. " +
+			"Quantities are impedance, resistance and reactance in Ω, admittance, conductance and susceptance in S, " +
+			"voltage in kV, power in MVA and current in A. Bases are three-phase: base_kv is the line-to-line voltage " +
+			"and base_mva the apparent power.",
+		Parameters: []function.Parameter{
+			function.Float64Parameter{
+				Name:        "value",
+				Description: "per-unit value",
+			},
+			function.StringParameter{
+				Name:        "quantity",
+				Description: "[impedance|resistance|reactance|admittance|conductance|susceptance|voltage|power|current] quantity of the value",
+			},
+			function.Float64Parameter{
+				Name:        "base_kv",
+				Description: "base line-to-line voltage in kV",
+			},
+			function.Float64Parameter{
+				Name:        "base_mva",
+				Description: "base apparent power in MVA",
+			},
+		},
+		Return: function.Float64Return{},
+	}
+}
+
+func (f *FromPuFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var value, baseKV, baseMVA float64
+	var quantity string
+
+	resp.Error = req.Arguments.Get(ctx, &value, &quantity, &baseKV, &baseMVA)
+	if resp.Error != nil {
+		return
+	}
+
+	result, err := electrical.FromPerUnit(value, quantity, baseKV, baseMVA)
+	if err != nil {
+		resp.Error = function.NewFuncError(fmt.Sprintf("unable to convert from per-unit: %s", err))
+		return
+	}
+
+	resp.Error = resp.Result.Set(ctx, result)
+}
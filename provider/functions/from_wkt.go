@@ -0,0 +1,59 @@
+package functions
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/splightplatform/terraform-provider-splight/splight/geo"
+)
+
+type FromWktFunction struct{}
+
+var _ function.Function = &FromWktFunction{}
+
+func NewFromWktFunction() function.Function {
+	return &FromWktFunction{}
+}
+
+func (f *FromWktFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "from_wkt"
+}
+
+func (f *FromWktFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Convert a WKT geometry to GeoJSON",
+		Description: "Converts a Well-Known Text geometry, e.g. \"LINESTRING (-58.38 -34.6, -58.4 -34.62)\", to a GeoJSON " +
+			"GeometryCollection in the canonical form the platform stores. Coordinates must be WGS84 longitude and latitude; " +
+			"Z values become altitudes and M values are dropped.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "wkt",
+				Description: "Well-Known Text (or EWKT with SRID=4326) geometry",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *FromWktFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var input string
+
+	resp.Error = req.Arguments.Get(ctx, &input)
+	if resp.Error != nil {
+		return
+	}
+
+	parsed, err := geo.ParseWKT(input)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(0, err.Error())
+		return
+	}
+
+	geometry, funcErr := canonicalGeometry(parsed)
+	if funcErr != nil {
+		resp.Error = funcErr
+		return
+	}
+
+	resp.Error = resp.Result.Set(ctx, geometry)
+}
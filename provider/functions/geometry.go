@@ -0,0 +1,33 @@
+package functions
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/splightplatform/terraform-provider-splight/splight/client/models"
+	"github.com/splightplatform/terraform-provider-splight/splight/geo"
+)
+
+// canonicalGeometry returns a geometry as the platform stores it, a normalized
+// GeometryCollection, so it doesn't show diffs after being read back
+func canonicalGeometry(geometry *geo.Geometry) (string, *function.FuncError) {
+	normalized, err := models.NormalizeGeometry(geometry.String())
+	if err != nil {
+		return "", function.NewFuncError(fmt.Sprintf("invalid geometry: %s", err))
+	}
+	return normalized, nil
+}
+
+// parseGeometryArgument validates and decodes a GeoJSON argument
+func parseGeometryArgument(argument int, geojson string) (*geo.Geometry, *function.FuncError) {
+	normalized, err := models.NormalizeGeometry(geojson)
+	if err != nil {
+		return nil, function.NewArgumentFuncError(int64(argument), fmt.Sprintf("invalid GeoJSON: %s", err))
+	}
+
+	geometry, err := geo.ParseGeometry(normalized)
+	if err != nil {
+		return nil, function.NewArgumentFuncError(int64(argument), err.Error())
+	}
+	return geometry, nil
+}
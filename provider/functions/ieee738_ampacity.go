@@ -0,0 +1,124 @@
+package functions
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/splightplatform/terraform-provider-splight/splight/electrical"
+)
+
+type ieee738Conductor struct {
+	Diameter                   float64 `tfsdk:"diameter"`
+	ReferenceResistance        float64 `tfsdk:"reference_resistance"`
+	TemperatureCoeffResistance float64 `tfsdk:"temperature_coeff_resistance"`
+	Absorptivity               float64 `tfsdk:"absorptivity"`
+	Emissivity                 float64 `tfsdk:"emissivity"`
+	MaximumAllowedTemperature  float64 `tfsdk:"maximum_allowed_temperature"`
+}
+
+type ieee738Weather struct {
+	AmbientTemperature float64 `tfsdk:"ambient_temperature"`
+	WindSpeed          float64 `tfsdk:"wind_speed"`
+	WindDirection      float64 `tfsdk:"wind_direction"`
+	Altitude           float64 `tfsdk:"altitude"`
+	Azimuth            float64 `tfsdk:"azimuth"`
+	Latitude           float64 `tfsdk:"latitude"`
+	DayOfYear          int64   `tfsdk:"day_of_year"`
+	SolarHour          float64 `tfsdk:"solar_hour"`
+	ClearAtmosphere    bool    `tfsdk:"clear_atmosphere"`
+}
+
+type Ieee738AmpacityFunction struct{}
+
+var _ function.Function = &Ieee738AmpacityFunction{}
+
+func NewIeee738AmpacityFunction() function.Function {
+	return &Ieee738AmpacityFunction{}
+}
+
+func (f *Ieee738AmpacityFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "ieee738_ampacity"
+}
+
+func (f *Ieee738AmpacityFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Steady-state thermal rating of a line following IEEE Std 738-2012",
+		Description: "Returns the current in amperes that keeps the conductor at its maximum allowed temperature " +
+			"under the given weather conditions. Conductor attributes use the units of the splight_line metadata: " +
+			"diameter in m, reference_resistance in Ω/km at 20 °C, temperature_coeff_resistance in 1/°C, " +
+			"maximum_allowed_temperature in °C. Weather attributes: ambient_temperature in °C, wind_speed in m/s, " +
+			"wind_direction, azimuth (of the line) and latitude in degrees, altitude in m, day_of_year from 1 to 366, " +
+			"solar_hour in local solar time (12 is solar noon) and clear_atmosphere (false for an industrial atmosphere).",
+		Parameters: []function.Parameter{
+			function.ObjectParameter{
+				Name:        "conductor",
+				Description: "conductor properties, as in the splight_line metadata",
+				AttributeTypes: map[string]attr.Type{
+					"diameter":                     types.Float64Type,
+					"reference_resistance":         types.Float64Type,
+					"temperature_coeff_resistance": types.Float64Type,
+					"absorptivity":                 types.Float64Type,
+					"emissivity":                   types.Float64Type,
+					"maximum_allowed_temperature":  types.Float64Type,
+				},
+			},
+			function.ObjectParameter{
+				Name:        "weather",
+				Description: "assumed ambient conditions and segment orientation",
+				AttributeTypes: map[string]attr.Type{
+					"ambient_temperature": types.Float64Type,
+					"wind_speed":          types.Float64Type,
+					"wind_direction":      types.Float64Type,
+					"altitude":            types.Float64Type,
+					"azimuth":             types.Float64Type,
+					"latitude":            types.Float64Type,
+					"day_of_year":         types.Int64Type,
+					"solar_hour":          types.Float64Type,
+					"clear_atmosphere":    types.BoolType,
+				},
+			},
+		},
+		Return: function.Float64Return{},
+	}
+}
+
+func (f *Ieee738AmpacityFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var conductor ieee738Conductor
+	var weather ieee738Weather
+
+	resp.Error = req.Arguments.Get(ctx, &conductor, &weather)
+	if resp.Error != nil {
+		return
+	}
+
+	ampacity, err := electrical.SteadyStateAmpacity(
+		electrical.Conductor{
+			Diameter:                   conductor.Diameter,
+			ReferenceResistance:        conductor.ReferenceResistance,
+			TemperatureCoeffResistance: conductor.TemperatureCoeffResistance,
+			Absorptivity:               conductor.Absorptivity,
+			Emissivity:                 conductor.Emissivity,
+			MaximumAllowedTemperature:  conductor.MaximumAllowedTemperature,
+		},
+		electrical.WeatherConditions{
+			AmbientTemperature: weather.AmbientTemperature,
+			WindSpeed:          weather.WindSpeed,
+			WindDirection:      weather.WindDirection,
+			Altitude:           weather.Altitude,
+			Azimuth:            weather.Azimuth,
+			Latitude:           weather.Latitude,
+			DayOfYear:          int(weather.DayOfYear),
+			SolarHour:          weather.SolarHour,
+			ClearAtmosphere:    weather.ClearAtmosphere,
+		},
+	)
+	if err != nil {
+		resp.Error = function.NewFuncError(fmt.Sprintf("unable to compute ampacity: %s", err))
+		return
+	}
+
+	resp.Error = resp.Result.Set(ctx, ampacity)
+}
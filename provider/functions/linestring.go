@@ -0,0 +1,70 @@
+package functions
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/splightplatform/terraform-provider-splight/splight/geo"
+)
+
+type LinestringFunction struct{}
+
+var _ function.Function = &LinestringFunction{}
+
+func NewLinestringFunction() function.Function {
+	return &LinestringFunction{}
+}
+
+func (f *LinestringFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "linestring"
+}
+
+func (f *LinestringFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Build the geometry of a line",
+		Description: "Returns a GeoJSON GeometryCollection with a single LineString through the given positions, " +
+			"in the canonical form the platform stores, ready to be used as the geometry of an asset.",
+		Parameters: []function.Parameter{
+			function.ListParameter{
+				Name:        "positions",
+				Description: "at least two [lon, lat] or [lon, lat, altitude] positions, in degrees and meters",
+				ElementType: types.ListType{ElemType: types.Float64Type},
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *LinestringFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var positions [][]float64
+
+	resp.Error = req.Arguments.Get(ctx, &positions)
+	if resp.Error != nil {
+		return
+	}
+
+	line := make([]geo.Position, len(positions))
+	for i, values := range positions {
+		if len(values) < 2 || len(values) > 3 {
+			resp.Error = function.NewArgumentFuncError(0, fmt.Sprintf("position %d must have 2 or 3 values, got %d", i, len(values)))
+			return
+		}
+		line[i] = geo.Position{Lon: values[0], Lat: values[1]}
+		if len(values) == 3 {
+			line[i].Alt = values[2]
+			line[i].HasAlt = true
+		}
+	}
+
+	coordinates, _ := json.Marshal(line)
+	geometry, err := canonicalGeometry(geo.NewGeometryCollection(&geo.Geometry{Type: "LineString", Coordinates: coordinates}))
+	if err != nil {
+		resp.Error = err
+		return
+	}
+
+	resp.Error = resp.Result.Set(ctx, geometry)
+}
@@ -0,0 +1,57 @@
+package functions
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/splightplatform/terraform-provider-splight/splight/electrical"
+)
+
+type PerKmFunction struct{}
+
+var _ function.Function = &PerKmFunction{}
+
+func NewPerKmFunction() function.Function {
+	return &PerKmFunction{}
+}
+
+func (f *PerKmFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "per_km"
+}
+
+func (f *PerKmFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Convert a total value of a line to its value per km",
+		Description: "Returns the value divided by the length of the line in km, e.g. to turn the total " +
+			"resistance of a line in Ω into Ω/km. Multiply by the length to go the other way.",
+		Parameters: []function.Parameter{
+			function.Float64Parameter{
+				Name:        "value",
+				Description: "total value over the whole line",
+			},
+			function.Float64Parameter{
+				Name:        "length",
+				Description: "length of the line in km",
+			},
+		},
+		Return: function.Float64Return{},
+	}
+}
+
+func (f *PerKmFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var value, length float64
+
+	resp.Error = req.Arguments.Get(ctx, &value, &length)
+	if resp.Error != nil {
+		return
+	}
+
+	result, err := electrical.PerKm(value, length)
+	if err != nil {
+		resp.Error = function.NewFuncError(fmt.Sprintf("unable to compute value per km: %s", err))
+		return
+	}
+
+	resp.Error = resp.Result.Set(ctx, result)
+}
@@ -0,0 +1,56 @@
+package functions
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/splightplatform/terraform-provider-splight/splight/geo"
+)
+
+type PointFunction struct{}
+
+var _ function.Function = &PointFunction{}
+
+func NewPointFunction() function.Function {
+	return &PointFunction{}
+}
+
+func (f *PointFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "point"
+}
+
+func (f *PointFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Build the geometry of a point",
+		Description: "Returns a GeoJSON GeometryCollection with a single Point, in the canonical form the platform " +
+			"stores, ready to be used as the geometry of an asset.",
+		Parameters: []function.Parameter{
+			function.Float64Parameter{
+				Name:        "lon",
+				Description: "longitude in degrees, between -180 and 180",
+			},
+			function.Float64Parameter{
+				Name:        "lat",
+				Description: "latitude in degrees, between -90 and 90",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *PointFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var lon, lat float64
+
+	resp.Error = req.Arguments.Get(ctx, &lon, &lat)
+	if resp.Error != nil {
+		return
+	}
+
+	geometry, err := canonicalGeometry(geo.NewGeometryCollection(geo.NewPoint(geo.Position{Lon: lon, Lat: lat})))
+	if err != nil {
+		resp.Error = err
+		return
+	}
+
+	resp.Error = resp.Result.Set(ctx, geometry)
+}
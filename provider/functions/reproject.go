@@ -0,0 +1,72 @@
+package functions
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/splightplatform/terraform-provider-splight/splight/client/models"
+	"github.com/splightplatform/terraform-provider-splight/splight/geo"
+)
+
+type ReprojectFunction struct{}
+
+var _ function.Function = &ReprojectFunction{}
+
+func NewReprojectFunction() function.Function {
+	return &ReprojectFunction{}
+}
+
+func (f *ReprojectFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "reproject"
+}
+
+func (f *ReprojectFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Reproject a geometry to WGS84",
+		Description: "Converts a GeoJSON geometry with projected coordinates, e.g. surveyed in a UTM zone or a national " +
+			"grid, to WGS84 longitude and latitude (EPSG:4326). Returns a GeometryCollection in the canonical form the " +
+			"platform stores. Supported systems are EPSG:4326, EPSG:3857, the WGS 84, SIRGAS 2000 and ETRS89 UTM zones " +
+			"and the POSGAR Argentina zones.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "geojson",
+				Description: "JSON encoded GeoJSON geometry, Feature or FeatureCollection",
+			},
+			function.StringParameter{
+				Name:        "crs",
+				Description: "coordinate reference system of the geometry, e.g. EPSG:32719",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *ReprojectFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var geojson, crs string
+
+	resp.Error = req.Arguments.Get(ctx, &geojson, &crs)
+	if resp.Error != nil {
+		return
+	}
+
+	system, err := geo.LookupCRS(crs)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(1, err.Error())
+		return
+	}
+
+	reprojected, err := geo.Reproject(geojson, system)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(0, err.Error())
+		return
+	}
+
+	normalized, err := models.NormalizeGeometry(reprojected)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(0, fmt.Sprintf("invalid geometry: %s", err))
+		return
+	}
+
+	resp.Error = resp.Result.Set(ctx, normalized)
+}
@@ -0,0 +1,68 @@
+package functions
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/splightplatform/terraform-provider-splight/splight/electrical"
+)
+
+type ToPuFunction struct{}
+
+var _ function.Function = &ToPuFunction{}
+
+func NewToPuFunction() function.Function {
+	return &ToPuFunction{}
+}
+
+func (f *ToPuFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "to_pu"
+}
+
+func (f *ToPuFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Convert a value to per-unit",
+		Description: "Returns the value divided by the base of its quantity. " +
+			"Quantities are impedance, resistance and reactance in Ω, admittance, conductance and susceptance in S, " +
+			"voltage in kV, power in MVA and current in A. Bases are three-phase: base_kv is the line-to-line voltage " +
+			"and base_mva the apparent power.",
+		Parameters: []function.Parameter{
+			function.Float64Parameter{
+				Name:        "value",
+				Description: "ohmic value, in the unit of its quantity",
+			},
+			function.StringParameter{
+				Name:        "quantity",
+				Description: "[impedance|resistance|reactance|admittance|conductance|susceptance|voltage|power|current] quantity of the value",
+			},
+			function.Float64Parameter{
+				Name:        "base_kv",
+				Description: "base line-to-line voltage in kV",
+			},
+			function.Float64Parameter{
+				Name:        "base_mva",
+				Description: "base apparent power in MVA",
+			},
+		},
+		Return: function.Float64Return{},
+	}
+}
+
+func (f *ToPuFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var value, baseKV, baseMVA float64
+	var quantity string
+
+	resp.Error = req.Arguments.Get(ctx, &value, &quantity, &baseKV, &baseMVA)
+	if resp.Error != nil {
+		return
+	}
+
+	result, err := electrical.ToPerUnit(value, quantity, baseKV, baseMVA)
+	if err != nil {
+		resp.Error = function.NewFuncError(fmt.Sprintf("unable to convert to per-unit: %s", err))
+		return
+	}
+
+	resp.Error = resp.Result.Set(ctx, result)
+}
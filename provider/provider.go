@@ -70,6 +70,9 @@ func buildResourceMap() map[string]*schema.Resource {
 		"splight_asset_relation":              resourceForType[*models.AssetRelation](schemas.SchemaAssetRelation),
 		"splight_asset_attribute":             resourceForType[*models.AssetAttribute](schemas.SchemaAssetAttribute),
 		"splight_asset_metadata":              resourceForType[*models.AssetMetadata](schemas.SchemaAssetMetadata),
+		"splight_asset_attributes":            resourceForType[*models.AssetAttributes](schemas.SchemaAssetAttributes, ResourceMethods{methods: Create | Read | Update | Delete}),
+		"splight_asset_metadata_set":          resourceForType[*models.AssetMetadataSet](schemas.SchemaAssetMetadataSet, ResourceMethods{methods: Create | Read | Update | Delete}),
+		"splight_asset_kind":                  resourceForType[*models.AssetKind](schemas.SchemaAssetKind),
 		"splight_grid":                        resourceForType[*models.Grid](schemas.SchemaGrid),
 		"splight_bus":                         resourceForType[*models.Bus](schemas.SchemaBus),
 		"splight_line":                        resourceForType[*models.Line](schemas.SchemaLine),
@@ -78,6 +81,11 @@ func buildResourceMap() map[string]*schema.Resource {
 		"splight_generator":                   resourceForType[*models.Generator](schemas.SchemaGenerator),
 		"splight_slack_generator":             resourceForType[*models.SlackGenerator](schemas.SchemaSlackGenerator),
 		"splight_inverter":                    resourceForType[*models.Inverter](schemas.SchemaInverter),
+		"splight_battery":                     resourceForType[*models.Battery](schemas.SchemaBattery),
+		"splight_load":                        resourceForType[*models.Load](schemas.SchemaLoad),
+		"splight_shunt":                       resourceForType[*models.Shunt](schemas.SchemaShunt),
+		"splight_substation":                  resourceForType[*models.Substation](schemas.SchemaSubstation),
+		"splight_switch":                      resourceForType[*models.Switch](schemas.SchemaSwitch),
 		"splight_tag":                         resourceForType[*models.Tag](schemas.SchemaTag),
 		"splight_alert":                       resourceForType[*models.Alert](schemas.SchemaAlert),
 		"splight_function":                    resourceForType[*models.Function](schemas.SchemaFunction),
@@ -115,11 +123,21 @@ func buildResourceMap() map[string]*schema.Resource {
 
 func buildDataSourceMap() map[string]*schema.Resource {
 	return map[string]*schema.Resource{
-		"splight_asset_kinds": dataSourceForType[*models.AssetKinds](schemas.SchemaAssetKinds),
-		"splight_tags":        dataSourceForType[*models.Tags](schemas.SchemaTags),
-		"splight_grids":       dataSourceForType[*models.Grid](schemas.SchemaTags),
-		"splight_buses":       dataSourceForType[*models.Bus](schemas.SchemaTags),
-		"splight_lines":       dataSourceForType[*models.Line](schemas.SchemaTags),
-		"splight_generators":  dataSourceForType[*models.Generator](schemas.SchemaTags),
+		"splight_asset_kinds":     dataSourceForType[*models.AssetKinds](schemas.SchemaAssetKinds),
+		"splight_asset_kind":      dataSourceAssetKind(schemas.SchemaAssetKindDataSource),
+		"splight_asset_subtree":   dataSourceAssetSubtree(schemas.SchemaAssetSubtree),
+		"splight_asset_relations": dataSourceAssetRelationGraph(schemas.SchemaAssetRelationGraph),
+		"splight_assets_in_area":  dataSourceAssetsInArea(schemas.SchemaAssetsInArea),
+		"splight_assets":          dataSourceAssets(schemas.SchemaAssets),
+		"splight_tags":            dataSourceForType[*models.Tags](schemas.SchemaTags),
+		"splight_grids":           dataSourceForType[*models.Grid](schemas.SchemaTags),
+		"splight_buses":           dataSourceForType[*models.Bus](schemas.SchemaTags),
+		"splight_lines":           dataSourceForType[*models.Line](schemas.SchemaTags),
+		"splight_generators":      dataSourceForType[*models.Generator](schemas.SchemaTags),
+
+		"splight_line_segments":    localDataSourceForType[*models.LineSegments](schemas.SchemaLineSegments),
+		"splight_conductor_type":   localDataSourceForType[*models.ConductorType](schemas.SchemaConductorType),
+		"splight_transformer_type": localDataSourceForType[*models.TransformerType](schemas.SchemaTransformerType),
+		"splight_geometry_file":    localDataSourceForType[*models.GeometryFile](schemas.SchemaGeometryFile),
 	}
 }
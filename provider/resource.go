@@ -2,11 +2,14 @@ package provider
 
 import (
 	"context"
+	"errors"
 	"net/http"
 	"reflect"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/splightplatform/terraform-provider-splight/provider/schemas"
 	"github.com/splightplatform/terraform-provider-splight/splight/client"
 	"github.com/splightplatform/terraform-provider-splight/splight/client/models"
 )
@@ -72,6 +75,44 @@ func resourceForType[T models.SplightModel](schemaFunc func() map[string]*schema
 		Schema: schemaFunc(),
 	}
 
+	if isMetadataSchema(resource.Schema) || len(metadataBlocks(resource.Schema)) > 0 {
+		resource.SchemaVersion = 1
+		resource.StateUpgraders = metadataStateUpgraders(schemaFunc)
+	}
+
+	if _, ok := resource.Schema["geometry_crs"]; ok {
+		resource.ValidateRawResourceConfigFuncs = append(resource.ValidateRawResourceConfigFuncs, schemas.ValidateGeometryConfig)
+	}
+
+	if validator, ok := any(InstantiateType[T]()).(models.ConfigValidator); ok {
+		resource.ValidateRawResourceConfigFuncs = append(resource.ValidateRawResourceConfigFuncs,
+			func(ctx context.Context, req schema.ValidateResourceConfigFuncRequest, resp *schema.ValidateResourceConfigFuncResponse) {
+				resp.Diagnostics = append(resp.Diagnostics, validator.ValidateConfig(req.RawConfig)...)
+			},
+		)
+	}
+
+	var customizeDiffs []schema.CustomizeDiffFunc
+
+	if _, ok := any(InstantiateType[T]()).(models.KindFieldsProvider); ok {
+		customizeDiffs = append(customizeDiffs, CustomizeKindFields)
+	}
+
+	if _, ok := resource.Schema["extra_attribute"]; ok {
+		// After the kind fields, which are planned along with the extras
+		customizeDiffs = append(customizeDiffs, CustomizeExtras)
+	}
+
+	if collection, ok := any(InstantiateType[T]()).(models.AssetCollection); ok {
+		block, recreatedBy := collection.CollectionBlock()
+		resource.ValidateRawResourceConfigFuncs = append(resource.ValidateRawResourceConfigFuncs, ValidateAssetCollection(block))
+		customizeDiffs = append(customizeDiffs, CustomizeAssetCollection(block, recreatedBy...))
+	}
+
+	if len(customizeDiffs) > 0 {
+		resource.CustomizeDiff = customdiff.All(customizeDiffs...)
+	}
+
 	if methodsToUse.Has(Create) {
 		resource.CreateContext = SaveResource[T]
 	}
@@ -104,6 +145,13 @@ func dataSourceForType[T models.DataSource](schemaFunc func() map[string]*schema
 	}
 }
 
+func localDataSourceForType[T models.LocalDataSource](schemaFunc func() map[string]*schema.Schema) *schema.Resource {
+	return &schema.Resource{
+		Schema:      schemaFunc(),
+		ReadContext: ComputeDataSource[T],
+	}
+}
+
 // InstantiateType creates a new instance of type T, ensuring that T is a pointer type
 // We could just use a switch too
 func InstantiateType[T models.SplightObject]() T {
@@ -121,7 +169,15 @@ func SaveResource[T models.SplightModel](ctx context.Context, d *schema.Resource
 	}
 
 	if err := client.Save(apiClient, model); err != nil {
-		return diag.Errorf("error creating resource: %s", err.Error())
+		// Keep what was saved, so it isn't created again on the next apply
+		diags := diag.Errorf("error creating resource: %s", err.Error())
+		var partialErr *client.PartialSaveError
+		if errors.As(err, &partialErr) {
+			if err := model.ToSchema(d); err != nil {
+				diags = append(diags, diag.Errorf("error mapping model to schema: %s", err.Error())...)
+			}
+		}
+		return diags
 	}
 
 	if err := model.ToSchema(d); err != nil {
@@ -135,6 +191,13 @@ func RetrieveResource[T models.SplightModel](ctx context.Context, d *schema.Reso
 	model := InstantiateType[T]()
 	apiClient := meta.(*client.Client)
 
+	// The extras aren't listed by the asset, they're refreshed from the state
+	if extras, ok := any(model).(models.ExtrasProvider); ok {
+		if err := extras.LoadExtras(d); err != nil {
+			return diag.Errorf("error reading extras from state: %s", err.Error())
+		}
+	}
+
 	if err := client.Retrieve(apiClient, model, d.Id()); err != nil {
 		if httpErr, ok := err.(*client.HttpError); ok && httpErr.StatusCode == http.StatusNotFound {
 			d.SetId("") // Resource not found, clear the Id to remove it from the state
@@ -165,10 +228,31 @@ func ListDataSource[T models.DataSource](ctx context.Context, d *schema.Resource
 	return nil
 }
 
+func ComputeDataSource[T models.LocalDataSource](ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	model := InstantiateType[T]()
+
+	if err := model.FromSchema(d); err != nil {
+		return diag.Errorf("error mapping schema to model: %s", err.Error())
+	}
+
+	if err := model.ToSchema(d); err != nil {
+		return diag.Errorf("error mapping model to schema: %s", err.Error())
+	}
+
+	return nil
+}
+
 func DeleteResource[T models.SplightModel](ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
 	model := InstantiateType[T]()
 	apiClient := meta.(*client.Client)
 
+	// Collections delete each of the items kept in the state
+	if collection, ok := any(model).(models.AssetCollection); ok {
+		if err := collection.LoadExtras(d); err != nil {
+			return diag.Errorf("error reading extras from state: %s", err.Error())
+		}
+	}
+
 	if err := client.Delete(apiClient, model, d.Id()); err != nil {
 		return diag.Errorf("error deleting resource with Id '%s': %s", d.Id(), err.Error())
 	}
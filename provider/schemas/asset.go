@@ -36,7 +36,7 @@ func JSONBytesEqual(b1, b2 []byte) bool {
 }
 
 func SchemaAsset() map[string]*schema.Schema {
-	return map[string]*schema.Schema{
+	schemaMap := map[string]*schema.Schema{
 		"name": {
 			Type:        schema.TypeString,
 			Required:    true,
@@ -51,7 +51,13 @@ func SchemaAsset() map[string]*schema.Schema {
 			Type:             schema.TypeString,
 			Optional:         true,
 			Description:      "GeoJSON GeomtryCollection",
-			DiffSuppressFunc: JSONStringEqualSupressFunc,
+			DiffSuppressFunc: GeometryEqualSuppressFunc,
+		},
+		"geometry_crs": {
+			Type:             schema.TypeString,
+			Optional:         true,
+			Description:      "coordinate reference system of the geometry coordinates, e.g. EPSG:32719 or EPSG:22185. They are reprojected to WGS84 (EPSG:4326), the default, before being sent",
+			ValidateDiagFunc: validateCRS,
 		},
 		"timezone": {
 			Type:        schema.TypeString,
@@ -59,9 +65,16 @@ func SchemaAsset() map[string]*schema.Schema {
 			Description: "timezone of the resource (set by the geo-location)",
 		},
 		"custom_timezone": {
+			Type:             schema.TypeString,
+			Optional:         true,
+			Description:      "custom timezone to use instead of the one computed from the geo-location, an IANA name such as America/Argentina/Buenos_Aires",
+			ValidateDiagFunc: validateTimezone,
+		},
+		"parent": {
 			Type:        schema.TypeString,
 			Optional:    true,
-			Description: "custom timezone to use instead of the one computed from the geo-location",
+			Computed:    true,
+			Description: "id of the asset this one is placed under in the hierarchy, e.g. the substation of a bus. A parent set outside Terraform is kept when omitted",
 		},
 		"tags": {
 			Type:        schema.TypeSet,
@@ -87,7 +100,7 @@ func SchemaAsset() map[string]*schema.Schema {
 			Optional:    true,
 			MaxItems:    1,
 			ForceNew:    true,
-			Description: "kind of the resource",
+			Description: "kind of the resource. The attributes and metadata it requires must be declared as extra_attribute and extra_metadata, or created with create_kind_fields",
 			Elem: &schema.Resource{
 				Schema: map[string]*schema.Schema{
 					"id": {
@@ -106,4 +119,13 @@ func SchemaAsset() map[string]*schema.Schema {
 			},
 		},
 	}
+
+	for key, value := range schemaAssetExtras() {
+		schemaMap[key] = value
+	}
+	for key, value := range schemaKindFields() {
+		schemaMap[key] = value
+	}
+
+	return schemaMap
 }
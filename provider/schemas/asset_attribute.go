@@ -23,11 +23,12 @@ func SchemaAssetAttribute() map[string]*schema.Schema {
 			}, false),
 		},
 		"unit": {
-			Type:        schema.TypeString,
-			Required:    false,
-			Optional:    true,
-			Description: "optional reference to the unit of the measure",
-			ForceNew:    true,
+			Type:             schema.TypeString,
+			Required:         false,
+			Optional:         true,
+			Description:      "optional reference to the unit of the measure",
+			ForceNew:         true,
+			ValidateDiagFunc: validateUnit,
 		},
 		"asset": {
 			Type:        schema.TypeString,
@@ -0,0 +1,31 @@
+package schemas
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func SchemaAssetAttributes() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"asset": {
+			Type:        schema.TypeString,
+			Required:    true,
+			Description: "reference to the asset the attributes belong to",
+			ForceNew:    true,
+		},
+		"attribute": {
+			Type:        schema.TypeList,
+			Optional:    true,
+			Computed:    true,
+			Description: "attributes of the asset, at least one, matched by name so only the ones that change are updated",
+			Elem:        schemaKeyedAttribute(),
+		},
+		"ids": {
+			Type:        schema.TypeMap,
+			Computed:    true,
+			Description: "ids of the attributes by name",
+			Elem: &schema.Schema{
+				Type: schema.TypeString,
+			},
+		},
+	}
+}
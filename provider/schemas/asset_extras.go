@@ -0,0 +1,196 @@
+package schemas
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// schemaKeyedMetadata is a metadata block item keyed by its name. Only the
+// value field that was set is kept, so items can be matched by name.
+func schemaKeyedMetadata() *schema.Resource {
+	return &schema.Resource{Schema: map[string]*schema.Schema{
+		"id": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "id of the metadata",
+		},
+		"name": {
+			Type:        schema.TypeString,
+			Required:    true,
+			Description: "name of the metadata, unique within the asset",
+		},
+		"type": {
+			Type:         schema.TypeString,
+			Required:     true,
+			Description:  "[String|Boolean|Number] type of the metadata value",
+			ValidateFunc: validation.StringInSlice([]string{"String", "Boolean", "Number"}, false),
+		},
+		"unit": {
+			Type:             schema.TypeString,
+			Optional:         true,
+			Description:      "optional reference to the unit of the measure. Quantities are converted to it, or keep their own unit when omitted",
+			ValidateDiagFunc: validateUnit,
+		},
+		"value": {
+			Type:             schema.TypeString,
+			Optional:         true,
+			Description:      "JSON encoded metadata value. Prefer number_value, string_value or bool_value, which are encoded by the provider",
+			DiffSuppressFunc: JSONStringEqualSupressFunc,
+		},
+		"number_value": {
+			Type:        schema.TypeFloat,
+			Optional:    true,
+			Description: "metadata value of a Number metadata",
+		},
+		"string_value": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: "metadata value of a String metadata",
+		},
+		"bool_value": {
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Description: "metadata value of a Boolean metadata",
+		},
+		"quantity": {
+			Type:             schema.TypeString,
+			Optional:         true,
+			Description:      "metadata value with its unit, e.g. \"12.3 km\", for Number metadata",
+			ValidateDiagFunc: validateQuantity,
+		},
+	}}
+}
+
+// schemaKeyedAttribute is an attribute block item keyed by its name
+func schemaKeyedAttribute() *schema.Resource {
+	return &schema.Resource{Schema: map[string]*schema.Schema{
+		"id": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "id of the attribute",
+		},
+		"name": {
+			Type:        schema.TypeString,
+			Required:    true,
+			Description: "name of the attribute, unique within the asset",
+		},
+		"type": {
+			Type:         schema.TypeString,
+			Required:     true,
+			Description:  "[String|Boolean|Number] type of the data to be ingested in this attribute",
+			ValidateFunc: validation.StringInSlice([]string{"String", "Boolean", "Number"}, false),
+		},
+		"unit": {
+			Type:             schema.TypeString,
+			Optional:         true,
+			Description:      "optional reference to the unit of the measure. Changing it creates the attribute again",
+			ValidateDiagFunc: validateUnit,
+		},
+	}}
+}
+
+// schemaComputedElem returns a copy of a block item schema whose fields are
+// all set by the provider
+func schemaComputedElem(resource *schema.Resource) *schema.Resource {
+	fields := map[string]*schema.Schema{}
+	for key, field := range resource.Schema {
+		fields[key] = &schema.Schema{
+			Type:        field.Type,
+			Computed:    true,
+			Description: field.Description,
+		}
+	}
+	return &schema.Resource{Schema: fields}
+}
+
+// schemaKindFields returns the fields of the assets whose kind expects some
+// attributes and metadata, which may be created along with them
+func schemaKindFields() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"create_kind_fields": {
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Default:     false,
+			Description: "create the attributes and metadata required by the kind that aren't declared as extra_attribute or extra_metadata, instead of failing the plan",
+		},
+		"kind_attribute": {
+			Type:        schema.TypeList,
+			Computed:    true,
+			Description: "attributes required by the kind that were created by the provider",
+			Elem:        schemaComputedElem(schemaKeyedAttribute()),
+		},
+		"kind_metadata": {
+			Type:        schema.TypeList,
+			Computed:    true,
+			Description: "metadata required by the kind that were created by the provider, with the default value of the kind",
+			Elem:        schemaComputedElem(schemaKeyedMetadata()),
+		},
+	}
+}
+
+// schemaAssetExtras returns the fields every typed asset shares to reference
+// its nested attributes and metadata, and to manage extra ones of its own
+func schemaAssetExtras() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"attributes": {
+			Type:        schema.TypeMap,
+			Computed:    true,
+			Description: "ids of the attributes of the resource by name, including the extra ones, e.g. attributes[\"active_power\"]. Their types and units are in attribute_types and attribute_units",
+			Elem: &schema.Schema{
+				Type: schema.TypeString,
+			},
+		},
+		"attribute_types": {
+			Type:        schema.TypeMap,
+			Computed:    true,
+			Description: "types of the attributes of the resource by name, including the extra ones",
+			Elem: &schema.Schema{
+				Type: schema.TypeString,
+			},
+		},
+		"attribute_units": {
+			Type:        schema.TypeMap,
+			Computed:    true,
+			Description: "units of the attributes of the resource by name, for the ones that have a unit",
+			Elem: &schema.Schema{
+				Type: schema.TypeString,
+			},
+		},
+		"metadata": {
+			Type:        schema.TypeMap,
+			Computed:    true,
+			Description: "ids of the metadata of the resource by name, including the extra ones. Their types and units are in metadata_types and metadata_units",
+			Elem: &schema.Schema{
+				Type: schema.TypeString,
+			},
+		},
+		"metadata_types": {
+			Type:        schema.TypeMap,
+			Computed:    true,
+			Description: "types of the metadata of the resource by name, including the extra ones",
+			Elem: &schema.Schema{
+				Type: schema.TypeString,
+			},
+		},
+		"metadata_units": {
+			Type:        schema.TypeMap,
+			Computed:    true,
+			Description: "units of the metadata of the resource by name, for the ones that have a unit",
+			Elem: &schema.Schema{
+				Type: schema.TypeString,
+			},
+		},
+		"extra_attribute": {
+			Type:        schema.TypeList,
+			Optional:    true,
+			Description: "additional attributes managed along with the resource",
+			Elem:        schemaKeyedAttribute(),
+		},
+		"extra_metadata": {
+			Type:        schema.TypeList,
+			Optional:    true,
+			Description: "additional metadata managed along with the resource. Set one of value, number_value, string_value, bool_value or quantity",
+			Elem:        schemaKeyedMetadata(),
+		},
+	}
+}
@@ -0,0 +1,145 @@
+package schemas
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// schemaAssetKindTemplate is an attribute or metadata template of a kind
+func schemaAssetKindTemplate(description string) *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Optional:    true,
+		Description: description,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"name": {
+					Type:        schema.TypeString,
+					Required:    true,
+					Description: "name of the field",
+				},
+				"type": {
+					Type:         schema.TypeString,
+					Required:     true,
+					Description:  "[String|Boolean|Number] type of the field",
+					ValidateFunc: validation.StringInSlice([]string{"String", "Boolean", "Number"}, false),
+				},
+				"unit": {
+					Type:             schema.TypeString,
+					Optional:         true,
+					Description:      "optional reference to the unit of the field",
+					ValidateDiagFunc: validateUnit,
+				},
+				"required": {
+					Type:        schema.TypeBool,
+					Optional:    true,
+					Default:     false,
+					Description: "whether the assets of the kind must have the field",
+				},
+				"value": {
+					Type:             schema.TypeString,
+					Optional:         true,
+					Description:      "JSON encoded default value, for metadata",
+					ValidateFunc:     validation.StringIsJSON,
+					DiffSuppressFunc: JSONStringEqualSupressFunc,
+				},
+			},
+		},
+	}
+}
+
+func SchemaAssetKind() map[string]*schema.Schema {
+	attributes := schemaAssetKindTemplate("attribute templates of the kind, checked on the assets of the kind")
+	delete(attributes.Elem.(*schema.Resource).Schema, "value")
+
+	return map[string]*schema.Schema{
+		"name": {
+			Type:        schema.TypeString,
+			Required:    true,
+			Description: "name of the kind, e.g. Weather Station",
+		},
+		"description": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: "description of the kind",
+		},
+		"icon": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: "name of the icon the platform shows for the assets of the kind",
+		},
+		"attributes": attributes,
+		"metadata":   schemaAssetKindTemplate("metadata templates of the kind, checked on the assets of the kind"),
+	}
+}
+
+// schemaAssetKindField is an attribute or metadata an asset kind expects
+func schemaAssetKindField(description string) *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Computed:    true,
+		Description: description,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"name": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "name of the field",
+				},
+				"type": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "[String|Boolean|Number] type of the field",
+				},
+				"unit": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "unit of the field, if any",
+				},
+				"required": {
+					Type:        schema.TypeBool,
+					Computed:    true,
+					Description: "whether the assets of the kind must have the field",
+				},
+				"value": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "JSON encoded default value, for metadata",
+				},
+			},
+		},
+	}
+}
+
+func SchemaAssetKindDataSource() map[string]*schema.Schema {
+	attributes := schemaAssetKindField("attributes the assets of the kind are expected to have")
+	delete(attributes.Elem.(*schema.Resource).Schema, "value")
+
+	return map[string]*schema.Schema{
+		"id": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			Computed:     true,
+			Description:  "id of the kind",
+			ExactlyOneOf: []string{"id", "name"},
+		},
+		"name": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Computed:    true,
+			Description: "name of the kind, e.g. Line",
+		},
+		"description": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "description of the kind",
+		},
+		"icon": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "name of the icon the platform shows for the assets of the kind",
+		},
+		"attributes": attributes,
+		"metadata":   schemaAssetKindField("metadata the assets of the kind are expected to have"),
+	}
+}
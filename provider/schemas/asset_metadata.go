@@ -5,8 +5,47 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
-func SchemaAssetMetadata() map[string]*schema.Schema {
+// schemaMetadataValue returns the fields holding a metadata value, either JSON
+// encoded, typed or with its unit. Only one of them can be set, and the typed
+// value matching the metadata type is filled back from the API.
+func schemaMetadataValue() map[string]*schema.Schema {
 	return map[string]*schema.Schema{
+		"value": {
+			Type:             schema.TypeString,
+			Optional:         true,
+			Computed:         true,
+			Description:      "JSON encoded metadata value. Prefer number_value, string_value or bool_value, which are encoded by the provider",
+			DiffSuppressFunc: JSONStringEqualSupressFunc,
+		},
+		"number_value": {
+			Type:        schema.TypeFloat,
+			Optional:    true,
+			Computed:    true,
+			Description: "metadata value of a Number metadata",
+		},
+		"string_value": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Computed:    true,
+			Description: "metadata value of a String metadata",
+		},
+		"bool_value": {
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Computed:    true,
+			Description: "metadata value of a Boolean metadata",
+		},
+		"quantity": {
+			Type:             schema.TypeString,
+			Optional:         true,
+			Description:      "metadata value with its unit, e.g. \"12.3 km\", for Number metadata. It is converted to the unit the metadata expects",
+			ValidateDiagFunc: validateQuantity,
+		},
+	}
+}
+
+func SchemaAssetMetadata() map[string]*schema.Schema {
+	schemaMap := map[string]*schema.Schema{
 		"name": {
 			Type:        schema.TypeString,
 			Required:    true,
@@ -23,15 +62,12 @@ func SchemaAssetMetadata() map[string]*schema.Schema {
 			}, false),
 		},
 		"unit": {
-			Type:        schema.TypeString,
-			Required:    false,
-			Optional:    true,
-			Description: "optional reference to the unit of the measure",
-		},
-		"value": {
-			Type:        schema.TypeString,
-			Required:    true,
-			Description: "metadata value",
+			Type:             schema.TypeString,
+			Required:         false,
+			Optional:         true,
+			Computed:         true,
+			Description:      "optional reference to the unit of the measure (set from the quantity when omitted)",
+			ValidateDiagFunc: validateUnit,
 		},
 		"asset": {
 			Type:        schema.TypeString,
@@ -40,4 +76,12 @@ func SchemaAssetMetadata() map[string]*schema.Schema {
 			ForceNew:    true,
 		},
 	}
+
+	valueKeys := []string{"value", "number_value", "string_value", "bool_value", "quantity"}
+	for key, valueSchema := range schemaMetadataValue() {
+		valueSchema.ExactlyOneOf = valueKeys
+		schemaMap[key] = valueSchema
+	}
+
+	return schemaMap
 }
@@ -0,0 +1,31 @@
+package schemas
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func SchemaAssetMetadataSet() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"asset": {
+			Type:        schema.TypeString,
+			Required:    true,
+			Description: "reference to the asset the metadata belong to",
+			ForceNew:    true,
+		},
+		"metadata": {
+			Type:        schema.TypeList,
+			Optional:    true,
+			Computed:    true,
+			Description: "metadata of the asset, at least one, matched by name so only the ones that change are updated. Set one of value, number_value, string_value, bool_value or quantity",
+			Elem:        schemaKeyedMetadata(),
+		},
+		"ids": {
+			Type:        schema.TypeMap,
+			Computed:    true,
+			Description: "ids of the metadata by name",
+			Elem: &schema.Schema{
+				Type: schema.TypeString,
+			},
+		},
+	}
+}
@@ -0,0 +1,94 @@
+package schemas
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func SchemaAssetRelationGraph() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"asset": {
+			Type:        schema.TypeString,
+			Required:    true,
+			Description: "id of the asset the graph is walked from",
+		},
+		"max_depth": {
+			Type:         schema.TypeInt,
+			Optional:     true,
+			Default:      0,
+			Description:  "how many relations away from the asset to walk, 1 for its own relations only. Defaults to the whole connected graph",
+			ValidateFunc: validation.IntAtLeast(0),
+		},
+		"direction": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			Default:      "both",
+			Description:  "[outgoing|incoming|both] follow the relations starting at each asset, the ones ending at it, or both",
+			ValidateFunc: validation.StringInSlice([]string{"outgoing", "incoming", "both"}, false),
+		},
+		"relations": {
+			Type:        schema.TypeList,
+			Computed:    true,
+			Description: "relations found, breadth first",
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"id": {
+						Type:        schema.TypeString,
+						Computed:    true,
+						Description: "relation id",
+					},
+					"name": {
+						Type:        schema.TypeString,
+						Computed:    true,
+						Description: "relation name",
+					},
+					"description": {
+						Type:        schema.TypeString,
+						Computed:    true,
+						Description: "relation description",
+					},
+					"related_asset_kind": {
+						Type:        schema.TypeString,
+						Computed:    true,
+						Description: "name of the kind of the target asset",
+					},
+					"asset": {
+						Type:        schema.TypeString,
+						Computed:    true,
+						Description: "id of the asset where the relation origins",
+					},
+					"asset_name": {
+						Type:        schema.TypeString,
+						Computed:    true,
+						Description: "name of the asset where the relation origins",
+					},
+					"related_asset": {
+						Type:        schema.TypeString,
+						Computed:    true,
+						Description: "id of the target asset, empty if the relation only has a kind",
+					},
+					"related_asset_name": {
+						Type:        schema.TypeString,
+						Computed:    true,
+						Description: "name of the target asset",
+					},
+					"depth": {
+						Type:        schema.TypeInt,
+						Computed:    true,
+						Description: "relations away from the asset, 1 for its own",
+					},
+				},
+			},
+		},
+		"dot": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "the graph in the Graphviz DOT language",
+		},
+		"json": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "the graph as JSON, with its nodes and edges",
+		},
+	}
+}
@@ -0,0 +1,57 @@
+package schemas
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func SchemaAssetSubtree() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"asset": {
+			Type:        schema.TypeString,
+			Required:    true,
+			Description: "id of the asset at the root of the subtree",
+		},
+		"max_depth": {
+			Type:         schema.TypeInt,
+			Optional:     true,
+			Default:      0,
+			Description:  "how many levels below the root to include, 1 for its direct children only. Defaults to the whole subtree",
+			ValidateFunc: validation.IntAtLeast(0),
+		},
+		"assets": {
+			Type:        schema.TypeList,
+			Computed:    true,
+			Description: "assets under the root, breadth first",
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"id": {
+						Type:        schema.TypeString,
+						Computed:    true,
+						Description: "asset id",
+					},
+					"name": {
+						Type:        schema.TypeString,
+						Computed:    true,
+						Description: "asset name",
+					},
+					"kind": {
+						Type:        schema.TypeString,
+						Computed:    true,
+						Description: "name of the kind of the asset",
+					},
+					"parent": {
+						Type:        schema.TypeString,
+						Computed:    true,
+						Description: "id of the asset it's placed under",
+					},
+					"depth": {
+						Type:        schema.TypeInt,
+						Computed:    true,
+						Description: "levels below the root, 1 for its direct children",
+					},
+				},
+			},
+		},
+	}
+}
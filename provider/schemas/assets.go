@@ -0,0 +1,122 @@
+package schemas
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func SchemaAssets() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"name": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: "exact name of the assets",
+		},
+		"name_prefix": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: "prefix of the name of the assets",
+		},
+		"name_regex": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			Description:  "regular expression the name of the assets must match, in RE2 syntax",
+			ValidateFunc: validation.StringIsValidRegExp,
+		},
+		"kind": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: "name of the kind of the assets",
+		},
+		"tags": {
+			Type:        schema.TypeSet,
+			Optional:    true,
+			Description: "names of the tags of the assets, matched as set in tags_match",
+			Elem: &schema.Schema{
+				Type: schema.TypeString,
+			},
+		},
+		"tags_match": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			Default:      "any",
+			Description:  "[any|all] whether the assets must have any of the tags or all of them",
+			ValidateFunc: validation.StringInSlice([]string{"any", "all"}, false),
+		},
+		"timezone": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: "timezone of the assets, their custom one when they use it, an IANA name such as America/Argentina/Buenos_Aires",
+		},
+		"attributes": {
+			Type:        schema.TypeSet,
+			Optional:    true,
+			Description: "names of attributes the assets must all have",
+			Elem: &schema.Schema{
+				Type: schema.TypeString,
+			},
+		},
+		"assets": {
+			Type:        schema.TypeList,
+			Computed:    true,
+			Description: "assets that match every filter",
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"id": {
+						Type:        schema.TypeString,
+						Computed:    true,
+						Description: "asset id",
+					},
+					"name": {
+						Type:        schema.TypeString,
+						Computed:    true,
+						Description: "asset name",
+					},
+					"kind": {
+						Type:        schema.TypeList,
+						Computed:    true,
+						Description: "kind of the asset",
+						Elem: &schema.Resource{
+							Schema: map[string]*schema.Schema{
+								"id": {
+									Type:        schema.TypeString,
+									Computed:    true,
+									Description: "kind id",
+								},
+								"name": {
+									Type:        schema.TypeString,
+									Computed:    true,
+									Description: "kind name",
+								},
+							},
+						},
+					},
+					"tags": {
+						Type:        schema.TypeList,
+						Computed:    true,
+						Description: "tags of the asset",
+						Elem: &schema.Resource{
+							Schema: map[string]*schema.Schema{
+								"id": {
+									Type:        schema.TypeString,
+									Computed:    true,
+									Description: "tag id",
+								},
+								"name": {
+									Type:        schema.TypeString,
+									Computed:    true,
+									Description: "tag name",
+								},
+							},
+						},
+					},
+					"geometry": {
+						Type:        schema.TypeString,
+						Computed:    true,
+						Description: "geo position and shape of the asset",
+					},
+				},
+			},
+		},
+	}
+}
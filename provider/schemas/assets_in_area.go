@@ -0,0 +1,79 @@
+package schemas
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func SchemaAssetsInArea() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"bbox": {
+			Type:         schema.TypeList,
+			Optional:     true,
+			MinItems:     4,
+			MaxItems:     4,
+			Description:  "area as a bounding box, [west, south, east, north] in degrees",
+			ExactlyOneOf: []string{"bbox", "area"},
+			Elem: &schema.Schema{
+				Type: schema.TypeFloat,
+			},
+		},
+		"area": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			Description:  "area as a GeoJSON Polygon or MultiPolygon, e.g. a service territory",
+			ExactlyOneOf: []string{"bbox", "area"},
+		},
+		"area_crs": {
+			Type:             schema.TypeString,
+			Optional:         true,
+			Description:      "coordinate reference system of the area coordinates, e.g. EPSG:32719 or EPSG:22185. Defaults to WGS84 (EPSG:4326)",
+			ValidateDiagFunc: validateCRS,
+			RequiredWith:     []string{"area"},
+		},
+		"kinds": {
+			Type:        schema.TypeSet,
+			Optional:    true,
+			Description: "names of the kinds of the assets to return, any kind if not set",
+			Elem: &schema.Schema{
+				Type: schema.TypeString,
+			},
+		},
+		"tags": {
+			Type:        schema.TypeSet,
+			Optional:    true,
+			Description: "names of the tags of the assets to return, assets with any of them. Any asset if not set",
+			Elem: &schema.Schema{
+				Type: schema.TypeString,
+			},
+		},
+		"assets": {
+			Type:        schema.TypeList,
+			Computed:    true,
+			Description: "assets whose geometry intersects the area, touching it included",
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"id": {
+						Type:        schema.TypeString,
+						Computed:    true,
+						Description: "asset id",
+					},
+					"name": {
+						Type:        schema.TypeString,
+						Computed:    true,
+						Description: "asset name",
+					},
+					"kind": {
+						Type:        schema.TypeString,
+						Computed:    true,
+						Description: "name of the kind of the asset",
+					},
+					"geometry": {
+						Type:        schema.TypeString,
+						Computed:    true,
+						Description: "geo position and shape of the asset",
+					},
+				},
+			},
+		},
+	}
+}
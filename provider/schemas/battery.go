@@ -0,0 +1,208 @@
+package schemas
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func SchemaBattery() map[string]*schema.Schema {
+	schemaMap := map[string]*schema.Schema{
+		"name": {
+			Type:        schema.TypeString,
+			Required:    true,
+			Description: "name of the resource",
+		},
+		"description": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: "description of the resource",
+		},
+		"geometry": {
+			Type:             schema.TypeString,
+			Optional:         true,
+			Description:      "geo position and shape of the resource",
+			DiffSuppressFunc: GeometryEqualSuppressFunc,
+		},
+		"geometry_crs": {
+			Type:             schema.TypeString,
+			Optional:         true,
+			Description:      "coordinate reference system of the geometry coordinates, e.g. EPSG:32719 or EPSG:22185. They are reprojected to WGS84 (EPSG:4326), the default, before being sent",
+			ValidateDiagFunc: validateCRS,
+		},
+		"timezone": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "timezone of the resource (set by the geo-location)",
+		},
+		"custom_timezone": {
+			Type:             schema.TypeString,
+			Optional:         true,
+			Description:      "custom timezone to use instead of the one computed from the geo-location, an IANA name such as America/Argentina/Buenos_Aires",
+			ValidateDiagFunc: validateTimezone,
+		},
+		"state_of_charge": {
+			Type:        schema.TypeSet,
+			Computed:    true,
+			Description: "attribute of the resource",
+			Elem: &schema.Resource{
+				Schema: schemaConstrainedAttribute(false),
+			},
+		},
+		"active_power": {
+			Type:        schema.TypeSet,
+			Computed:    true,
+			Description: "attribute of the resource",
+			Elem: &schema.Resource{
+				Schema: schemaConstrainedAttribute(false),
+			},
+		},
+		"reactive_power": {
+			Type:        schema.TypeSet,
+			Computed:    true,
+			Description: "attribute of the resource",
+			Elem: &schema.Resource{
+				Schema: schemaConstrainedAttribute(false),
+			},
+		},
+		"charge_energy": {
+			Type:        schema.TypeSet,
+			Computed:    true,
+			Description: "attribute of the resource",
+			Elem: &schema.Resource{
+				Schema: schemaConstrainedAttribute(false),
+			},
+		},
+		"discharge_energy": {
+			Type:        schema.TypeSet,
+			Computed:    true,
+			Description: "attribute of the resource",
+			Elem: &schema.Resource{
+				Schema: schemaConstrainedAttribute(false),
+			},
+		},
+		"switch_status": {
+			Type:        schema.TypeSet,
+			Computed:    true,
+			Description: "attribute of the resource",
+			Elem: &schema.Resource{
+				Schema: schemaConstrainedAttribute(false),
+			},
+		},
+		"rated_energy": {
+			Type:        schema.TypeSet,
+			Optional:    true,
+			Computed:    true,
+			MaxItems:    1,
+			Description: "energy capacity of the battery, in MWh",
+			Set:         hashMetadataValue("rated_energy"),
+			Elem: &schema.Resource{
+				Schema: schemaConstrainedAttribute(true),
+			},
+		},
+		"rated_power": {
+			Type:        schema.TypeSet,
+			Optional:    true,
+			Computed:    true,
+			MaxItems:    1,
+			Description: "maximum charge and discharge power of the battery, in MW",
+			Set:         hashMetadataValue("rated_power"),
+			Elem: &schema.Resource{
+				Schema: schemaConstrainedAttribute(true),
+			},
+		},
+		"round_trip_efficiency": {
+			Type:        schema.TypeSet,
+			Optional:    true,
+			Computed:    true,
+			MaxItems:    1,
+			Description: "fraction of the charged energy that can be discharged, in %",
+			Set:         hashMetadataValue("round_trip_efficiency"),
+			Elem: &schema.Resource{
+				Schema: schemaConstrainedAttribute(true),
+			},
+		},
+		"min_soc": {
+			Type:        schema.TypeSet,
+			Optional:    true,
+			Computed:    true,
+			MaxItems:    1,
+			Description: "minimum state of charge the battery is operated at, in %",
+			Set:         hashMetadataValue("min_soc"),
+			Elem: &schema.Resource{
+				Schema: schemaConstrainedAttribute(true),
+			},
+		},
+		"max_soc": {
+			Type:        schema.TypeSet,
+			Optional:    true,
+			Computed:    true,
+			MaxItems:    1,
+			Description: "maximum state of charge the battery is operated at, in %",
+			Set:         hashMetadataValue("max_soc"),
+			Elem: &schema.Resource{
+				Schema: schemaConstrainedAttribute(true),
+			},
+		},
+		"parent": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Computed:    true,
+			Description: "id of the asset this one is placed under in the hierarchy, e.g. the substation of a bus. A parent set outside Terraform is kept when omitted",
+		},
+		"tags": {
+			Type:        schema.TypeSet,
+			Optional:    true,
+			Description: "tags of the resource",
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"id": {
+						Type:        schema.TypeString,
+						Required:    true,
+						Description: "tag id",
+					},
+					"name": {
+						Type:        schema.TypeString,
+						Required:    true,
+						Description: "tag name",
+					},
+				},
+			},
+		},
+		"kind": {
+			Type:        schema.TypeSet,
+			Computed:    true,
+			Description: "kind of the resource",
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"id": {
+						Type:        schema.TypeString,
+						Required:    true,
+						ForceNew:    true,
+						Description: "kind id",
+					},
+					"name": {
+						Type:        schema.TypeString,
+						Required:    true,
+						ForceNew:    true,
+						Description: "kind name",
+					},
+				},
+			},
+		},
+		"bus": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: "id of the related Bus object",
+		},
+		"grid": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: "id of the related Grid object",
+		},
+	}
+
+	for key, value := range schemaAssetExtras() {
+		schemaMap[key] = value
+	}
+
+	return schemaMap
+}
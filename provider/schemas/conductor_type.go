@@ -0,0 +1,61 @@
+package schemas
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/splightplatform/terraform-provider-splight/splight/electrical"
+)
+
+func SchemaConductorType() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"name": {
+			Type:         schema.TypeString,
+			Required:     true,
+			Description:  "name of the conductor in the catalog, e.g. \"ACSR Drake\"",
+			ValidateFunc: validation.StringInSlice(electrical.ConductorTypeNames(), false),
+		},
+		"stranding": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "number of aluminum/steel strands",
+		},
+		"diameter": {
+			Type:        schema.TypeFloat,
+			Computed:    true,
+			Description: "outer diameter in m",
+		},
+		"reference_resistance": {
+			Type:        schema.TypeFloat,
+			Computed:    true,
+			Description: "DC resistance in Ω/km at 20 °C",
+		},
+		"conductor_mass": {
+			Type:        schema.TypeFloat,
+			Computed:    true,
+			Description: "mass per unit length in kg/m",
+		},
+		"specific_heat": {
+			Type:        schema.TypeFloat,
+			Computed:    true,
+			Description: "specific heat in J/(kg·°C)",
+		},
+		"thermal_elongation_coef": {
+			Type:        schema.TypeFloat,
+			Computed:    true,
+			Description: "coefficient of linear thermal expansion in 1/°C",
+		},
+		"temperature_coeff_resistance": {
+			Type:        schema.TypeFloat,
+			Computed:    true,
+			Description: "temperature coefficient of resistance in 1/°C",
+		},
+		"units": {
+			Type:        schema.TypeMap,
+			Computed:    true,
+			Description: "unit of each property",
+			Elem: &schema.Schema{
+				Type: schema.TypeString,
+			},
+		},
+	}
+}
@@ -84,9 +84,10 @@ func schemaDashboardChart() map[string]*schema.Schema {
 			Description: "whether to show data which is beyond timestamp_lte or not",
 		},
 		"timezone": {
-			Type:        schema.TypeString,
-			Optional:    true,
-			Description: "chart timezone",
+			Type:             schema.TypeString,
+			Optional:         true,
+			Description:      "chart timezone, an IANA name such as America/Argentina/Buenos_Aires",
+			ValidateDiagFunc: validateTimezone,
 		},
 		"timestamp_lte": {
 			Type:        schema.TypeString,
@@ -0,0 +1,80 @@
+package schemas
+
+import (
+	"context"
+	"strings"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/splightplatform/terraform-provider-splight/splight/client/models"
+	"github.com/splightplatform/terraform-provider-splight/splight/geo"
+)
+
+// GeometryEqualSuppressFunc suppresses diffs between geometries that are the
+// same once normalized, e.g. when only the coordinate precision or the
+// GeometryCollection wrapping differ. The configured geometry is reprojected
+// from its geometry_crs first, since the state always holds WGS84.
+func GeometryEqualSuppressFunc(k, old, new string, d *schema.ResourceData) bool {
+	if d != nil {
+		if crs, ok := d.Get("geometry_crs").(string); ok && crs != "" {
+			reprojected, err := models.ReprojectGeometry(new, crs)
+			if err != nil {
+				return false
+			}
+			new = reprojected
+		}
+	}
+
+	return models.GeometryEqual(old, new)
+}
+
+// validateCRS checks that a coordinate reference system is supported
+func validateCRS(v any, path cty.Path) diag.Diagnostics {
+	if _, err := geo.LookupCRS(v.(string)); err != nil {
+		return diag.Diagnostics{{
+			Severity:      diag.Error,
+			Summary:       "Unsupported coordinate reference system",
+			Detail:        err.Error(),
+			AttributePath: path,
+		}}
+	}
+
+	return nil
+}
+
+// ValidateGeometryConfig checks the geometry against the RFC 7946 shape rules
+// once reprojected from its geometry_crs. It runs on the whole configuration
+// since projected coordinates can't be range checked on their own.
+func ValidateGeometryConfig(ctx context.Context, req schema.ValidateResourceConfigFuncRequest, resp *schema.ValidateResourceConfigFuncResponse) {
+	geometry, ok := models.ConfigString(req.RawConfig, "geometry")
+	if !ok || geometry == "" {
+		return
+	}
+
+	var crs string
+	if req.RawConfig.Type().HasAttribute("geometry_crs") {
+		value := req.RawConfig.GetAttr("geometry_crs")
+		if !value.IsKnown() {
+			// The reference system is unknown until apply
+			return
+		}
+		if !value.IsNull() {
+			crs = value.AsString()
+		}
+	}
+
+	if _, err := models.NormalizeGeometryCRS(geometry, crs); err != nil {
+		detail := err.Error()
+		if crs == "" && strings.Contains(detail, "out of range") {
+			detail += ". If the coordinates are projected, set geometry_crs to their reference system, e.g. EPSG:32719"
+		}
+
+		resp.Diagnostics = append(resp.Diagnostics, diag.Diagnostic{
+			Severity:      diag.Error,
+			Summary:       "Invalid GeoJSON geometry",
+			Detail:        detail,
+			AttributePath: cty.GetAttrPath("geometry"),
+		})
+	}
+}
@@ -0,0 +1,83 @@
+package schemas
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/splightplatform/terraform-provider-splight/splight/client/models"
+)
+
+func SchemaGeometryFile() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"path": {
+			Type:        schema.TypeString,
+			Required:    true,
+			Description: "path to a GeoJSON, KML or shapefile (a .zip with its .shp, .dbf and .prj, or the .shp itself)",
+		},
+		"format": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			Computed:     true,
+			Description:  "format of the file, one of geojson, kml or shapefile. Inferred from the extension if not set",
+			ValidateFunc: validation.StringInSlice(models.GeometryFileFormats, false),
+		},
+		"crs": {
+			Type:             schema.TypeString,
+			Optional:         true,
+			Description:      "coordinate reference system of the file, e.g. EPSG:32719. Overrides the one declared by the file (the .prj of a shapefile or the crs member of a GeoJSON), which defaults to WGS84",
+			ValidateDiagFunc: validateCRS,
+		},
+		"key_property": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: "property whose value is used as the feature key. Defaults to the feature id, then its name, then its position in the file",
+		},
+		"geometries": {
+			Type:        schema.TypeMap,
+			Computed:    true,
+			Description: "canonical GeoJSON geometry of each feature by key, ready to be used as the geometry of an asset",
+			Elem: &schema.Schema{
+				Type: schema.TypeString,
+			},
+		},
+		"properties": {
+			Type:        schema.TypeMap,
+			Computed:    true,
+			Description: "JSON encoded properties of each feature by key",
+			Elem: &schema.Schema{
+				Type: schema.TypeString,
+			},
+		},
+		"features": {
+			Type:        schema.TypeList,
+			Computed:    true,
+			Description: "features of the file, in order",
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"key": {
+						Type:        schema.TypeString,
+						Computed:    true,
+						Description: "key of the feature",
+					},
+					"name": {
+						Type:        schema.TypeString,
+						Computed:    true,
+						Description: "name of the feature, if any",
+					},
+					"geometry": {
+						Type:        schema.TypeString,
+						Computed:    true,
+						Description: "canonical GeoJSON geometry of the feature",
+					},
+					"properties": {
+						Type:        schema.TypeMap,
+						Computed:    true,
+						Description: "properties of the feature, values other than strings are JSON encoded",
+						Elem: &schema.Schema{
+							Type: schema.TypeString,
+						},
+					},
+				},
+			},
+		},
+	}
+}
@@ -5,7 +5,7 @@ import (
 )
 
 func SchemaGrid() map[string]*schema.Schema {
-	return map[string]*schema.Schema{
+	schemaMap := map[string]*schema.Schema{
 		"name": {
 			Type:        schema.TypeString,
 			Required:    true,
@@ -20,7 +20,13 @@ func SchemaGrid() map[string]*schema.Schema {
 			Type:             schema.TypeString,
 			Optional:         true,
 			Description:      "geo position and shape of the resource",
-			DiffSuppressFunc: JSONStringEqualSupressFunc,
+			DiffSuppressFunc: GeometryEqualSuppressFunc,
+		},
+		"geometry_crs": {
+			Type:             schema.TypeString,
+			Optional:         true,
+			Description:      "coordinate reference system of the geometry coordinates, e.g. EPSG:32719 or EPSG:22185. They are reprojected to WGS84 (EPSG:4326), the default, before being sent",
+			ValidateDiagFunc: validateCRS,
 		},
 		"timezone": {
 			Type:        schema.TypeString,
@@ -28,9 +34,16 @@ func SchemaGrid() map[string]*schema.Schema {
 			Description: "timezone of the resource (set by the geo-location)",
 		},
 		"custom_timezone": {
+			Type:             schema.TypeString,
+			Optional:         true,
+			Description:      "custom timezone to use instead of the one computed from the geo-location, an IANA name such as America/Argentina/Buenos_Aires",
+			ValidateDiagFunc: validateTimezone,
+		},
+		"parent": {
 			Type:        schema.TypeString,
 			Optional:    true,
-			Description: "custom timezone to use instead of the one computed from the geo-location",
+			Computed:    true,
+			Description: "id of the asset this one is placed under in the hierarchy, e.g. the substation of a bus. A parent set outside Terraform is kept when omitted",
 		},
 		"tags": {
 			Type:        schema.TypeSet,
@@ -73,4 +86,10 @@ func SchemaGrid() map[string]*schema.Schema {
 			},
 		},
 	}
+
+	for key, value := range schemaAssetExtras() {
+		schemaMap[key] = value
+	}
+
+	return schemaMap
 }
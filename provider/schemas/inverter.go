@@ -5,7 +5,7 @@ import (
 )
 
 func SchemaInverter() map[string]*schema.Schema {
-	return map[string]*schema.Schema{
+	schemaMap := map[string]*schema.Schema{
 		"name": {
 			Type:        schema.TypeString,
 			Required:    true,
@@ -20,7 +20,13 @@ func SchemaInverter() map[string]*schema.Schema {
 			Type:             schema.TypeString,
 			Optional:         true,
 			Description:      "geo position and shape of the resource",
-			DiffSuppressFunc: JSONStringEqualSupressFunc,
+			DiffSuppressFunc: GeometryEqualSuppressFunc,
+		},
+		"geometry_crs": {
+			Type:             schema.TypeString,
+			Optional:         true,
+			Description:      "coordinate reference system of the geometry coordinates, e.g. EPSG:32719 or EPSG:22185. They are reprojected to WGS84 (EPSG:4326), the default, before being sent",
+			ValidateDiagFunc: validateCRS,
 		},
 		"timezone": {
 			Type:        schema.TypeString,
@@ -28,9 +34,10 @@ func SchemaInverter() map[string]*schema.Schema {
 			Description: "timezone of the resource (set by the geo-location)",
 		},
 		"custom_timezone": {
-			Type:        schema.TypeString,
-			Optional:    true,
-			Description: "custom timezone to use instead of the one computed from the geo-location",
+			Type:             schema.TypeString,
+			Optional:         true,
+			Description:      "custom timezone to use instead of the one computed from the geo-location, an IANA name such as America/Argentina/Buenos_Aires",
+			ValidateDiagFunc: validateTimezone,
 		},
 		"accumulated_energy": {
 			Type:        schema.TypeSet,
@@ -85,6 +92,7 @@ func SchemaInverter() map[string]*schema.Schema {
 			Optional:    true,
 			MaxItems:    1,
 			Description: "attribute of the resource",
+			Set:         hashMetadataValue("make"),
 			Elem: &schema.Resource{
 				Schema: schemaConstrainedAttribute(true),
 			},
@@ -94,6 +102,7 @@ func SchemaInverter() map[string]*schema.Schema {
 			Optional:    true,
 			MaxItems:    1,
 			Description: "attribute of the resource",
+			Set:         hashMetadataValue("model"),
 			Elem: &schema.Resource{
 				Schema: schemaConstrainedAttribute(true),
 			},
@@ -103,6 +112,7 @@ func SchemaInverter() map[string]*schema.Schema {
 			Optional:    true,
 			MaxItems:    1,
 			Description: "attribute of the resource",
+			Set:         hashMetadataValue("serial_number"),
 			Elem: &schema.Resource{
 				Schema: schemaConstrainedAttribute(true),
 			},
@@ -112,6 +122,7 @@ func SchemaInverter() map[string]*schema.Schema {
 			Optional:    true,
 			MaxItems:    1,
 			Description: "attribute of the resource",
+			Set:         hashMetadataValue("max_active_power"),
 			Elem: &schema.Resource{
 				Schema: schemaConstrainedAttribute(true),
 			},
@@ -121,10 +132,17 @@ func SchemaInverter() map[string]*schema.Schema {
 			Optional:    true,
 			MaxItems:    1,
 			Description: "attribute of the resource",
+			Set:         hashMetadataValue("energy_measurement_type"),
 			Elem: &schema.Resource{
 				Schema: schemaConstrainedAttribute(true),
 			},
 		},
+		"parent": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Computed:    true,
+			Description: "id of the asset this one is placed under in the hierarchy, e.g. the substation of a bus. A parent set outside Terraform is kept when omitted",
+		},
 		"tags": {
 			Type:        schema.TypeSet,
 			Optional:    true,
@@ -166,4 +184,10 @@ func SchemaInverter() map[string]*schema.Schema {
 			},
 		},
 	}
+
+	for key, value := range schemaAssetExtras() {
+		schemaMap[key] = value
+	}
+
+	return schemaMap
 }
@@ -1,7 +1,12 @@
 package schemas
 
 import (
+	"encoding/json"
+
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/splightplatform/terraform-provider-splight/splight/electrical"
+	"github.com/splightplatform/terraform-provider-splight/splight/units"
 )
 
 func schemaConstrainedAttribute(isMetadata bool) map[string]*schema.Schema {
@@ -33,22 +38,61 @@ func schemaConstrainedAttribute(isMetadata bool) map[string]*schema.Schema {
 		},
 	}
 
-	// Add "value" field only if it's metadata
+	// Add the value fields only if it's metadata
 	if isMetadata {
-		valueSchema := &schema.Schema{
-			Type:        schema.TypeString,
-			Required:    true,
-			Description: "metadata value",
+		for key, valueSchema := range schemaMetadataValue() {
+			schemaMap[key] = valueSchema
 		}
-
-		schemaMap["value"] = valueSchema
 	}
 
 	return schemaMap
 }
 
+// hashMetadataValue hashes a metadata block by its decoded value, whichever
+// field holds it. The typed values are also computed, so the default hash
+// would differ between the configuration and the state. Quantities are
+// converted to the unit expected for the block, as the stored value is.
+func hashMetadataValue(block string) schema.SchemaSetFunc {
+	return func(v any) int {
+		item := v.(map[string]any)
+
+		var value any
+		if valueStr, _ := item["value"].(string); valueStr != "" {
+			if err := json.Unmarshal([]byte(valueStr), &value); err != nil {
+				return schema.HashString(valueStr)
+			}
+		} else if quantity, _ := item["quantity"].(string); quantity != "" {
+			number, unit, err := units.ParseQuantity(quantity)
+			if err != nil {
+				return schema.HashString(quantity)
+			}
+			if expected, ok := electrical.MetadataUnits[block]; ok {
+				if number, err = units.Convert(number, unit.Symbol, expected); err != nil {
+					return schema.HashString(quantity)
+				}
+			}
+			value = number
+		} else if str, _ := item["string_value"].(string); str != "" {
+			value = str
+		} else if number, _ := item["number_value"].(float64); number != 0 {
+			value = number
+		} else if boolean, _ := item["bool_value"].(bool); boolean {
+			value = boolean
+		}
+
+		// Unset typed values can't be told apart from zero values
+		switch value {
+		case nil, "", 0.0, false:
+			return schema.HashString("")
+		}
+
+		encoded, _ := json.Marshal(value)
+		return schema.HashString(string(encoded))
+	}
+}
+
 func SchemaLine() map[string]*schema.Schema {
-	return map[string]*schema.Schema{
+	schemaMap := map[string]*schema.Schema{
 		"name": {
 			Type:        schema.TypeString,
 			Required:    true,
@@ -63,7 +107,13 @@ func SchemaLine() map[string]*schema.Schema {
 			Type:             schema.TypeString,
 			Optional:         true,
 			Description:      "geo position and shape of the resource",
-			DiffSuppressFunc: JSONStringEqualSupressFunc,
+			DiffSuppressFunc: GeometryEqualSuppressFunc,
+		},
+		"geometry_crs": {
+			Type:             schema.TypeString,
+			Optional:         true,
+			Description:      "coordinate reference system of the geometry coordinates, e.g. EPSG:32719 or EPSG:22185. They are reprojected to WGS84 (EPSG:4326), the default, before being sent",
+			ValidateDiagFunc: validateCRS,
 		},
 		"timezone": {
 			Type:        schema.TypeString,
@@ -71,9 +121,21 @@ func SchemaLine() map[string]*schema.Schema {
 			Description: "timezone of the resource (set by the geo-location)",
 		},
 		"custom_timezone": {
-			Type:        schema.TypeString,
+			Type:             schema.TypeString,
+			Optional:         true,
+			Description:      "custom timezone to use instead of the one computed from the geo-location, an IANA name such as America/Argentina/Buenos_Aires",
+			ValidateDiagFunc: validateTimezone,
+		},
+		"length_from_geometry": {
+			Type:        schema.TypeBool,
 			Optional:    true,
-			Description: "custom timezone to use instead of the one computed from the geo-location",
+			Description: "compute the length metadata (in km) from the geodesic length of the geometry. An explicit length block takes precedence and is checked against the geometry",
+		},
+		"conductor_type": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			Description:  "standard conductor from the catalog (see the splight_conductor_type data source) used to fill the diameter, reference_resistance, conductor_mass, specific_heat, thermal_elongation_coef and temperature_coeff_resistance metadata that are not set explicitly",
+			ValidateFunc: validation.StringInSlice(electrical.ConductorTypeNames(), false),
 		},
 		"active_power": {
 			Type:        schema.TypeSet,
@@ -209,6 +271,7 @@ func SchemaLine() map[string]*schema.Schema {
 			Computed:    true,
 			MaxItems:    1,
 			Description: "attribute of the resource",
+			Set:         hashMetadataValue("diameter"),
 			Elem: &schema.Resource{
 				Schema: schemaConstrainedAttribute(true),
 			},
@@ -219,6 +282,7 @@ func SchemaLine() map[string]*schema.Schema {
 			Computed:    true,
 			MaxItems:    1,
 			Description: "attribute of the resource",
+			Set:         hashMetadataValue("absorptivity"),
 			Elem: &schema.Resource{
 				Schema: schemaConstrainedAttribute(true),
 			},
@@ -229,6 +293,7 @@ func SchemaLine() map[string]*schema.Schema {
 			Computed:    true,
 			MaxItems:    1,
 			Description: "attribute of the resource",
+			Set:         hashMetadataValue("atmosphere"),
 			Elem: &schema.Resource{
 				Schema: schemaConstrainedAttribute(true),
 			},
@@ -239,6 +304,7 @@ func SchemaLine() map[string]*schema.Schema {
 			Computed:    true,
 			MaxItems:    1,
 			Description: "attribute of the resource",
+			Set:         hashMetadataValue("capacitance"),
 			Elem: &schema.Resource{
 				Schema: schemaConstrainedAttribute(true),
 			},
@@ -249,6 +315,7 @@ func SchemaLine() map[string]*schema.Schema {
 			Computed:    true,
 			MaxItems:    1,
 			Description: "attribute of the resource",
+			Set:         hashMetadataValue("conductance"),
 			Elem: &schema.Resource{
 				Schema: schemaConstrainedAttribute(true),
 			},
@@ -259,6 +326,7 @@ func SchemaLine() map[string]*schema.Schema {
 			Computed:    true,
 			MaxItems:    1,
 			Description: "attribute of the resource",
+			Set:         hashMetadataValue("emissivity"),
 			Elem: &schema.Resource{
 				Schema: schemaConstrainedAttribute(true),
 			},
@@ -269,6 +337,7 @@ func SchemaLine() map[string]*schema.Schema {
 			Computed:    true,
 			MaxItems:    1,
 			Description: "attribute of the resource",
+			Set:         hashMetadataValue("length"),
 			Elem: &schema.Resource{
 				Schema: schemaConstrainedAttribute(true),
 			},
@@ -279,6 +348,7 @@ func SchemaLine() map[string]*schema.Schema {
 			Computed:    true,
 			MaxItems:    1,
 			Description: "attribute of the resource",
+			Set:         hashMetadataValue("maximum_allowed_current"),
 			Elem: &schema.Resource{
 				Schema: schemaConstrainedAttribute(true),
 			},
@@ -289,6 +359,7 @@ func SchemaLine() map[string]*schema.Schema {
 			Computed:    true,
 			MaxItems:    1,
 			Description: "attribute of the resource",
+			Set:         hashMetadataValue("maximum_allowed_power"),
 			Elem: &schema.Resource{
 				Schema: schemaConstrainedAttribute(true),
 			},
@@ -299,6 +370,7 @@ func SchemaLine() map[string]*schema.Schema {
 			Computed:    true,
 			MaxItems:    1,
 			Description: "attribute of the resource",
+			Set:         hashMetadataValue("maximum_allowed_temperature"),
 			Elem: &schema.Resource{
 				Schema: schemaConstrainedAttribute(true),
 			},
@@ -309,6 +381,7 @@ func SchemaLine() map[string]*schema.Schema {
 			Computed:    true,
 			MaxItems:    1,
 			Description: "attribute of the resource",
+			Set:         hashMetadataValue("maximum_allowed_temperature_lte"),
 			Elem: &schema.Resource{
 				Schema: schemaConstrainedAttribute(true),
 			},
@@ -319,6 +392,7 @@ func SchemaLine() map[string]*schema.Schema {
 			Computed:    true,
 			MaxItems:    1,
 			Description: "attribute of the resource",
+			Set:         hashMetadataValue("maximum_allowed_temperature_ste"),
 			Elem: &schema.Resource{
 				Schema: schemaConstrainedAttribute(true),
 			},
@@ -329,6 +403,7 @@ func SchemaLine() map[string]*schema.Schema {
 			Computed:    true,
 			MaxItems:    1,
 			Description: "attribute of the resource",
+			Set:         hashMetadataValue("number_of_conductors"),
 			Elem: &schema.Resource{
 				Schema: schemaConstrainedAttribute(true),
 			},
@@ -339,6 +414,7 @@ func SchemaLine() map[string]*schema.Schema {
 			Computed:    true,
 			MaxItems:    1,
 			Description: "attribute of the resource",
+			Set:         hashMetadataValue("reactance"),
 			Elem: &schema.Resource{
 				Schema: schemaConstrainedAttribute(true),
 			},
@@ -349,6 +425,7 @@ func SchemaLine() map[string]*schema.Schema {
 			Computed:    true,
 			MaxItems:    1,
 			Description: "attribute of the resource",
+			Set:         hashMetadataValue("reference_resistance"),
 			Elem: &schema.Resource{
 				Schema: schemaConstrainedAttribute(true),
 			},
@@ -359,6 +436,7 @@ func SchemaLine() map[string]*schema.Schema {
 			Computed:    true,
 			MaxItems:    1,
 			Description: "attribute of the resource",
+			Set:         hashMetadataValue("resistance"),
 			Elem: &schema.Resource{
 				Schema: schemaConstrainedAttribute(true),
 			},
@@ -369,6 +447,7 @@ func SchemaLine() map[string]*schema.Schema {
 			Computed:    true,
 			MaxItems:    1,
 			Description: "attribute of the resource",
+			Set:         hashMetadataValue("safety_margin_for_power"),
 			Elem: &schema.Resource{
 				Schema: schemaConstrainedAttribute(true),
 			},
@@ -379,6 +458,7 @@ func SchemaLine() map[string]*schema.Schema {
 			Computed:    true,
 			MaxItems:    1,
 			Description: "attribute of the resource",
+			Set:         hashMetadataValue("susceptance"),
 			Elem: &schema.Resource{
 				Schema: schemaConstrainedAttribute(true),
 			},
@@ -389,6 +469,7 @@ func SchemaLine() map[string]*schema.Schema {
 			Computed:    true,
 			MaxItems:    1,
 			Description: "attribute of the resource",
+			Set:         hashMetadataValue("temperature_coeff_resistance"),
 			Elem: &schema.Resource{
 				Schema: schemaConstrainedAttribute(true),
 			},
@@ -399,6 +480,7 @@ func SchemaLine() map[string]*schema.Schema {
 			Computed:    true,
 			MaxItems:    1,
 			Description: "attribute of the resource",
+			Set:         hashMetadataValue("specific_heat"),
 			Elem: &schema.Resource{
 				Schema: schemaConstrainedAttribute(true),
 			},
@@ -409,6 +491,7 @@ func SchemaLine() map[string]*schema.Schema {
 			Computed:    true,
 			MaxItems:    1,
 			Description: "attribute of the resource",
+			Set:         hashMetadataValue("conductor_mass"),
 			Elem: &schema.Resource{
 				Schema: schemaConstrainedAttribute(true),
 			},
@@ -419,10 +502,17 @@ func SchemaLine() map[string]*schema.Schema {
 			Computed:    true,
 			MaxItems:    1,
 			Description: "attribute of the resource",
+			Set:         hashMetadataValue("thermal_elongation_coef"),
 			Elem: &schema.Resource{
 				Schema: schemaConstrainedAttribute(true),
 			},
 		},
+		"parent": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Computed:    true,
+			Description: "id of the asset this one is placed under in the hierarchy, e.g. the substation of a bus. A parent set outside Terraform is kept when omitted",
+		},
 		"tags": {
 			Type:        schema.TypeSet,
 			Optional:    true,
@@ -465,4 +555,10 @@ func SchemaLine() map[string]*schema.Schema {
 			},
 		},
 	}
+
+	for key, value := range schemaAssetExtras() {
+		schemaMap[key] = value
+	}
+
+	return schemaMap
 }
@@ -0,0 +1,57 @@
+package schemas
+
+import "github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+func SchemaLineSegments() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"geometry": {
+			Type:        schema.TypeString,
+			Required:    true,
+			Description: "GeoJSON with a single LineString (optionally inside a GeometryCollection). A third coordinate is read as the vertex altitude in meters",
+		},
+		"length": {
+			Type:        schema.TypeFloat,
+			Computed:    true,
+			Description: "geodesic length of the line in meters",
+		},
+		"segments": {
+			Type:        schema.TypeList,
+			Computed:    true,
+			Description: "one segment per span between consecutive vertices",
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"index": {
+						Type:        schema.TypeInt,
+						Computed:    true,
+						Description: "position of the span in the line, starting at 0",
+					},
+					"span_length": {
+						Type:        schema.TypeFloat,
+						Computed:    true,
+						Description: "geodesic length of the span in meters",
+					},
+					"azimuth": {
+						Type:        schema.TypeFloat,
+						Computed:    true,
+						Description: "initial azimuth of the span in degrees, clockwise from true north",
+					},
+					"cumulative_distance": {
+						Type:        schema.TypeFloat,
+						Computed:    true,
+						Description: "distance in meters along the line from its first vertex to the end of the span",
+					},
+					"altitude": {
+						Type:        schema.TypeFloat,
+						Computed:    true,
+						Description: "mean altitude of the span in meters (0 when the LineString has no altitudes)",
+					},
+					"geometry": {
+						Type:        schema.TypeString,
+						Computed:    true,
+						Description: "GeoJSON GeometryCollection with a Point at the midpoint of the span",
+					},
+				},
+			},
+		},
+	}
+}
@@ -5,7 +5,7 @@ import (
 )
 
 func SchemaSegment() map[string]*schema.Schema {
-	return map[string]*schema.Schema{
+	schemaMap := map[string]*schema.Schema{
 		"name": {
 			Type:        schema.TypeString,
 			Required:    true,
@@ -20,7 +20,13 @@ func SchemaSegment() map[string]*schema.Schema {
 			Type:             schema.TypeString,
 			Optional:         true,
 			Description:      "geo position and shape of the resource",
-			DiffSuppressFunc: JSONStringEqualSupressFunc,
+			DiffSuppressFunc: GeometryEqualSuppressFunc,
+		},
+		"geometry_crs": {
+			Type:             schema.TypeString,
+			Optional:         true,
+			Description:      "coordinate reference system of the geometry coordinates, e.g. EPSG:32719 or EPSG:22185. They are reprojected to WGS84 (EPSG:4326), the default, before being sent",
+			ValidateDiagFunc: validateCRS,
 		},
 		"timezone": {
 			Type:        schema.TypeString,
@@ -28,9 +34,10 @@ func SchemaSegment() map[string]*schema.Schema {
 			Description: "timezone of the resource (set by the geo-location)",
 		},
 		"custom_timezone": {
-			Type:        schema.TypeString,
-			Optional:    true,
-			Description: "custom timezone to use instead of the one computed from the geo-location",
+			Type:             schema.TypeString,
+			Optional:         true,
+			Description:      "custom timezone to use instead of the one computed from the geo-location, an IANA name such as America/Argentina/Buenos_Aires",
+			ValidateDiagFunc: validateTimezone,
 		},
 		"temperature": {
 			Type:        schema.TypeSet,
@@ -61,6 +68,7 @@ func SchemaSegment() map[string]*schema.Schema {
 			Optional:    true,
 			MaxItems:    1,
 			Description: "attribute of the resource",
+			Set:         hashMetadataValue("altitude"),
 			Elem: &schema.Resource{
 				Schema: schemaConstrainedAttribute(true),
 			},
@@ -70,6 +78,7 @@ func SchemaSegment() map[string]*schema.Schema {
 			Optional:    true,
 			MaxItems:    1,
 			Description: "attribute of the resource",
+			Set:         hashMetadataValue("azimuth"),
 			Elem: &schema.Resource{
 				Schema: schemaConstrainedAttribute(true),
 			},
@@ -79,6 +88,7 @@ func SchemaSegment() map[string]*schema.Schema {
 			Optional:    true,
 			MaxItems:    1,
 			Description: "attribute of the resource",
+			Set:         hashMetadataValue("cumulative_distance"),
 			Elem: &schema.Resource{
 				Schema: schemaConstrainedAttribute(true),
 			},
@@ -88,6 +98,7 @@ func SchemaSegment() map[string]*schema.Schema {
 			Optional:    true,
 			MaxItems:    1,
 			Description: "attribute of the resource",
+			Set:         hashMetadataValue("reference_sag"),
 			Elem: &schema.Resource{
 				Schema: schemaConstrainedAttribute(true),
 			},
@@ -97,6 +108,7 @@ func SchemaSegment() map[string]*schema.Schema {
 			Optional:    true,
 			MaxItems:    1,
 			Description: "attribute of the resource",
+			Set:         hashMetadataValue("reference_temperature"),
 			Elem: &schema.Resource{
 				Schema: schemaConstrainedAttribute(true),
 			},
@@ -106,10 +118,17 @@ func SchemaSegment() map[string]*schema.Schema {
 			Optional:    true,
 			MaxItems:    1,
 			Description: "attribute of the resource",
+			Set:         hashMetadataValue("span_length"),
 			Elem: &schema.Resource{
 				Schema: schemaConstrainedAttribute(true),
 			},
 		},
+		"parent": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Computed:    true,
+			Description: "id of the asset this one is placed under in the hierarchy, e.g. the substation of a bus. A parent set outside Terraform is kept when omitted",
+		},
 		"tags": {
 			Type:        schema.TypeSet,
 			Optional:    true,
@@ -151,4 +170,10 @@ func SchemaSegment() map[string]*schema.Schema {
 			},
 		},
 	}
+
+	for key, value := range schemaAssetExtras() {
+		schemaMap[key] = value
+	}
+
+	return schemaMap
 }
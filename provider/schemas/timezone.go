@@ -0,0 +1,27 @@
+package schemas
+
+import (
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/splightplatform/terraform-provider-splight/splight/timezones"
+)
+
+// validateTimezone checks a timezone against the IANA database, suggesting
+// the closest names for typos. Empty means no timezone.
+func validateTimezone(v any, path cty.Path) diag.Diagnostics {
+	timezone := v.(string)
+	if timezone == "" {
+		return nil
+	}
+
+	if err := timezones.Validate(timezone); err != nil {
+		return diag.Diagnostics{{
+			Severity:      diag.Error,
+			Summary:       "Invalid timezone",
+			Detail:        err.Error(),
+			AttributePath: path,
+		}}
+	}
+
+	return nil
+}
@@ -5,7 +5,7 @@ import (
 )
 
 func SchemaTransformer() map[string]*schema.Schema {
-	return map[string]*schema.Schema{
+	schemaMap := map[string]*schema.Schema{
 		"name": {
 			Type:        schema.TypeString,
 			Required:    true,
@@ -20,7 +20,13 @@ func SchemaTransformer() map[string]*schema.Schema {
 			Type:             schema.TypeString,
 			Optional:         true,
 			Description:      "geo position and shape of the resource",
-			DiffSuppressFunc: JSONStringEqualSupressFunc,
+			DiffSuppressFunc: GeometryEqualSuppressFunc,
+		},
+		"geometry_crs": {
+			Type:             schema.TypeString,
+			Optional:         true,
+			Description:      "coordinate reference system of the geometry coordinates, e.g. EPSG:32719 or EPSG:22185. They are reprojected to WGS84 (EPSG:4326), the default, before being sent",
+			ValidateDiagFunc: validateCRS,
 		},
 		"timezone": {
 			Type:        schema.TypeString,
@@ -28,9 +34,10 @@ func SchemaTransformer() map[string]*schema.Schema {
 			Description: "timezone of the resource (set by the geo-location)",
 		},
 		"custom_timezone": {
-			Type:        schema.TypeString,
-			Optional:    true,
-			Description: "custom timezone to use instead of the one computed from the geo-location",
+			Type:             schema.TypeString,
+			Optional:         true,
+			Description:      "custom timezone to use instead of the one computed from the geo-location, an IANA name such as America/Argentina/Buenos_Aires",
+			ValidateDiagFunc: validateTimezone,
 		},
 		"active_power_hv": {
 			Type:        schema.TypeSet,
@@ -141,6 +148,7 @@ func SchemaTransformer() map[string]*schema.Schema {
 			Optional:    true,
 			MaxItems:    1,
 			Description: "attribute of the resource",
+			Set:         hashMetadataValue("tap_pos"),
 			Elem: &schema.Resource{
 				Schema: schemaConstrainedAttribute(true),
 			},
@@ -150,6 +158,7 @@ func SchemaTransformer() map[string]*schema.Schema {
 			Optional:    true,
 			MaxItems:    1,
 			Description: "attribute of the resource",
+			Set:         hashMetadataValue("xn_ohm"),
 			Elem: &schema.Resource{
 				Schema: schemaConstrainedAttribute(true),
 			},
@@ -158,7 +167,8 @@ func SchemaTransformer() map[string]*schema.Schema {
 			Type:        schema.TypeSet,
 			Optional:    true,
 			MaxItems:    1,
-			Description: "attribute of the resource",
+			Description: "attribute of the resource. When its value names a standard transformer from the catalog (see the splight_transformer_type data source), the resistance, reactance, conductance and maximum_allowed_power metadata that are not set explicitly are derived from it",
+			Set:         hashMetadataValue("standard_type"),
 			Elem: &schema.Resource{
 				Schema: schemaConstrainedAttribute(true),
 			},
@@ -166,8 +176,10 @@ func SchemaTransformer() map[string]*schema.Schema {
 		"capacitance": {
 			Type:        schema.TypeSet,
 			Optional:    true,
+			Computed:    true,
 			MaxItems:    1,
 			Description: "attribute of the resource",
+			Set:         hashMetadataValue("capacitance"),
 			Elem: &schema.Resource{
 				Schema: schemaConstrainedAttribute(true),
 			},
@@ -175,8 +187,10 @@ func SchemaTransformer() map[string]*schema.Schema {
 		"conductance": {
 			Type:        schema.TypeSet,
 			Optional:    true,
+			Computed:    true,
 			MaxItems:    1,
 			Description: "attribute of the resource",
+			Set:         hashMetadataValue("conductance"),
 			Elem: &schema.Resource{
 				Schema: schemaConstrainedAttribute(true),
 			},
@@ -186,6 +200,7 @@ func SchemaTransformer() map[string]*schema.Schema {
 			Optional:    true,
 			MaxItems:    1,
 			Description: "attribute of the resource",
+			Set:         hashMetadataValue("maximum_allowed_current"),
 			Elem: &schema.Resource{
 				Schema: schemaConstrainedAttribute(true),
 			},
@@ -193,8 +208,10 @@ func SchemaTransformer() map[string]*schema.Schema {
 		"maximum_allowed_power": {
 			Type:        schema.TypeSet,
 			Optional:    true,
+			Computed:    true,
 			MaxItems:    1,
 			Description: "attribute of the resource",
+			Set:         hashMetadataValue("maximum_allowed_power"),
 			Elem: &schema.Resource{
 				Schema: schemaConstrainedAttribute(true),
 			},
@@ -202,8 +219,10 @@ func SchemaTransformer() map[string]*schema.Schema {
 		"reactance": {
 			Type:        schema.TypeSet,
 			Optional:    true,
+			Computed:    true,
 			MaxItems:    1,
 			Description: "attribute of the resource",
+			Set:         hashMetadataValue("reactance"),
 			Elem: &schema.Resource{
 				Schema: schemaConstrainedAttribute(true),
 			},
@@ -211,8 +230,10 @@ func SchemaTransformer() map[string]*schema.Schema {
 		"resistance": {
 			Type:        schema.TypeSet,
 			Optional:    true,
+			Computed:    true,
 			MaxItems:    1,
 			Description: "attribute of the resource",
+			Set:         hashMetadataValue("resistance"),
 			Elem: &schema.Resource{
 				Schema: schemaConstrainedAttribute(true),
 			},
@@ -222,10 +243,17 @@ func SchemaTransformer() map[string]*schema.Schema {
 			Optional:    true,
 			MaxItems:    1,
 			Description: "attribute of the resource",
+			Set:         hashMetadataValue("safety_margin_for_power"),
 			Elem: &schema.Resource{
 				Schema: schemaConstrainedAttribute(true),
 			},
 		},
+		"parent": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Computed:    true,
+			Description: "id of the asset this one is placed under in the hierarchy, e.g. the substation of a bus. A parent set outside Terraform is kept when omitted",
+		},
 		"tags": {
 			Type:        schema.TypeSet,
 			Optional:    true,
@@ -267,4 +295,10 @@ func SchemaTransformer() map[string]*schema.Schema {
 			},
 		},
 	}
+
+	for key, value := range schemaAssetExtras() {
+		schemaMap[key] = value
+	}
+
+	return schemaMap
 }
@@ -0,0 +1,133 @@
+package schemas
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/splightplatform/terraform-provider-splight/splight/electrical"
+)
+
+func SchemaTransformerType() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"name": {
+			Type:         schema.TypeString,
+			Required:     true,
+			Description:  "name of the transformer in the catalog, e.g. \"0.4 MVA 20/0.4 kV\"",
+			ValidateFunc: validation.StringInSlice(electrical.TransformerTypeNames(), false),
+		},
+		"sn_mva": {
+			Type:        schema.TypeFloat,
+			Computed:    true,
+			Description: "rated apparent power in MVA",
+		},
+		"vn_hv_kv": {
+			Type:        schema.TypeFloat,
+			Computed:    true,
+			Description: "rated voltage of the high voltage side in kV",
+		},
+		"vn_lv_kv": {
+			Type:        schema.TypeFloat,
+			Computed:    true,
+			Description: "rated voltage of the low voltage side in kV",
+		},
+		"vk_percent": {
+			Type:        schema.TypeFloat,
+			Computed:    true,
+			Description: "short-circuit voltage in percent of the rated voltage",
+		},
+		"vkr_percent": {
+			Type:        schema.TypeFloat,
+			Computed:    true,
+			Description: "real part of the short-circuit voltage in percent",
+		},
+		"pfe_kw": {
+			Type:        schema.TypeFloat,
+			Computed:    true,
+			Description: "iron losses in kW",
+		},
+		"i0_percent": {
+			Type:        schema.TypeFloat,
+			Computed:    true,
+			Description: "open-circuit current in percent of the rated current",
+		},
+		"shift_degree": {
+			Type:        schema.TypeFloat,
+			Computed:    true,
+			Description: "phase shift between the high and low voltage sides in degrees",
+		},
+		"vector_group": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "vector group, e.g. \"Dyn5\"",
+		},
+		"tap_side": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "[hv|lv] side of the tap changer",
+		},
+		"tap_neutral": {
+			Type:        schema.TypeInt,
+			Computed:    true,
+			Description: "neutral tap position",
+		},
+		"tap_min": {
+			Type:        schema.TypeInt,
+			Computed:    true,
+			Description: "minimum tap position",
+		},
+		"tap_max": {
+			Type:        schema.TypeInt,
+			Computed:    true,
+			Description: "maximum tap position",
+		},
+		"tap_step_percent": {
+			Type:        schema.TypeFloat,
+			Computed:    true,
+			Description: "voltage step per tap in percent",
+		},
+		"tap_step_degree": {
+			Type:        schema.TypeFloat,
+			Computed:    true,
+			Description: "phase shift step per tap in degrees",
+		},
+		"resistance_pu": {
+			Type:        schema.TypeFloat,
+			Computed:    true,
+			Description: "series resistance in per-unit on the rated power and the high voltage side",
+		},
+		"reactance_pu": {
+			Type:        schema.TypeFloat,
+			Computed:    true,
+			Description: "series reactance in per-unit on the rated power and the high voltage side",
+		},
+		"conductance_pu": {
+			Type:        schema.TypeFloat,
+			Computed:    true,
+			Description: "magnetizing conductance in per-unit on the rated power and the high voltage side",
+		},
+		"susceptance_pu": {
+			Type:        schema.TypeFloat,
+			Computed:    true,
+			Description: "magnetizing susceptance in per-unit on the rated power and the high voltage side",
+		},
+		"resistance": {
+			Type:        schema.TypeFloat,
+			Computed:    true,
+			Description: "series resistance in Ω referred to the high voltage side",
+		},
+		"reactance": {
+			Type:        schema.TypeFloat,
+			Computed:    true,
+			Description: "series reactance in Ω referred to the high voltage side",
+		},
+		"conductance": {
+			Type:        schema.TypeFloat,
+			Computed:    true,
+			Description: "magnetizing conductance in S referred to the high voltage side",
+		},
+		"susceptance": {
+			Type:        schema.TypeFloat,
+			Computed:    true,
+			Description: "magnetizing susceptance in S referred to the high voltage side",
+		},
+	}
+}
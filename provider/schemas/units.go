@@ -0,0 +1,43 @@
+package schemas
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/splightplatform/terraform-provider-splight/splight/units"
+)
+
+// validateUnit warns about units missing from the catalog. They are still
+// accepted, since the platform allows free-form units.
+func validateUnit(v any, path cty.Path) diag.Diagnostics {
+	unit := v.(string)
+	if unit == "" {
+		return nil
+	}
+
+	if _, err := units.Lookup(unit); err != nil {
+		return diag.Diagnostics{{
+			Severity:      diag.Warning,
+			Summary:       "Unknown unit",
+			Detail:        fmt.Sprintf("%q is not a known unit, so values using it can't be converted.", unit),
+			AttributePath: path,
+		}}
+	}
+
+	return nil
+}
+
+// validateQuantity checks that a value is a number followed by a known unit
+func validateQuantity(v any, path cty.Path) diag.Diagnostics {
+	if _, _, err := units.ParseQuantity(v.(string)); err != nil {
+		return diag.Diagnostics{{
+			Severity:      diag.Error,
+			Summary:       "Invalid quantity",
+			Detail:        err.Error(),
+			AttributePath: path,
+		}}
+	}
+
+	return nil
+}
@@ -0,0 +1,97 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/splightplatform/terraform-provider-splight/splight/client/models"
+)
+
+// typedMetadataKeys are the typed metadata values added in schema version 1
+var typedMetadataKeys = []string{"number_value", "string_value", "bool_value"}
+
+// isMetadataSchema reports whether a schema holds a metadata value
+func isMetadataSchema(schemaMap map[string]*schema.Schema) bool {
+	_, ok := schemaMap["number_value"]
+	return ok
+}
+
+// metadataBlocks returns the nested blocks of a schema that hold a metadata value
+func metadataBlocks(schemaMap map[string]*schema.Schema) []string {
+	var blocks []string
+	for key, value := range schemaMap {
+		if elem, ok := value.Elem.(*schema.Resource); ok && isMetadataSchema(elem.Schema) {
+			blocks = append(blocks, key)
+		}
+	}
+	return blocks
+}
+
+// metadataStateUpgraders migrates states written when metadata values were only
+// JSON encoded strings, filling the typed value that matches the metadata type.
+func metadataStateUpgraders(schemaFunc func() map[string]*schema.Schema) []schema.StateUpgrader {
+	// Schema version 0 is the current one without the typed values
+	schemaV0 := schemaFunc()
+	removeTypedMetadata := func(schemaMap map[string]*schema.Schema) {
+		for _, key := range typedMetadataKeys {
+			delete(schemaMap, key)
+		}
+		delete(schemaMap, "quantity")
+	}
+	removeTypedMetadata(schemaV0)
+	for _, block := range metadataBlocks(schemaV0) {
+		removeTypedMetadata(schemaV0[block].Elem.(*schema.Resource).Schema)
+	}
+	resourceV0 := &schema.Resource{Schema: schemaV0}
+
+	current := schemaFunc()
+	standalone := isMetadataSchema(current)
+	blocks := metadataBlocks(current)
+
+	return []schema.StateUpgrader{
+		{
+			Version: 0,
+			Type:    resourceV0.CoreConfigSchema().ImpliedType(),
+			Upgrade: func(ctx context.Context, rawState map[string]any, meta any) (map[string]any, error) {
+				if rawState == nil {
+					return rawState, nil
+				}
+
+				if standalone {
+					upgradeMetadataValue(rawState)
+				}
+
+				for _, block := range blocks {
+					items, _ := rawState[block].([]any)
+					for _, item := range items {
+						if metadata, ok := item.(map[string]any); ok {
+							upgradeMetadataValue(metadata)
+						}
+					}
+				}
+
+				return rawState, nil
+			},
+		},
+	}
+}
+
+func upgradeMetadataValue(state map[string]any) {
+	metadataType, _ := state["type"].(string)
+	value, _ := state["value"].(string)
+
+	metadata := models.AssetMetadata{
+		AssetMetadataParams: models.AssetMetadataParams{
+			Type:  metadataType,
+			Value: json.RawMessage(value),
+		},
+	}
+	typed := metadata.ToMap()
+
+	for _, key := range typedMetadataKeys {
+		if typedValue, ok := typed[key]; ok {
+			state[key] = typedValue
+		}
+	}
+}
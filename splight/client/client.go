@@ -197,3 +197,20 @@ type HttpError struct {
 func (e *HttpError) Error() string {
 	return e.Message
 }
+
+// PartialSaveError is returned by Save when a resource was saved but not all
+// of the attributes and metadata managed along with it. The model holds the
+// ones saved so far, so they can be kept in the state.
+type PartialSaveError struct {
+	Err error
+}
+
+// Error returns the message of the underlying error
+func (e *PartialSaveError) Error() string {
+	return e.Err.Error()
+}
+
+// Unwrap returns the underlying error
+func (e *PartialSaveError) Unwrap() error {
+	return e.Err
+}
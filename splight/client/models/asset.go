@@ -16,11 +16,24 @@ type AssetParams struct {
 	UseCustomTimezone bool             `json:"use_custom_timezone"`
 	Tags              []QueryFilter    `json:"tags"`
 	Kind              *QueryFilter     `json:"kind"`
+	Parent            *ResourceId      `json:"parent,omitempty"`
 }
 
 type Asset struct {
 	AssetParams
-	Id string `json:"id"`
+	AssetExtras
+	KindFields AssetExtras `json:"-"`
+	Id         string      `json:"id"`
+}
+
+// convertParent returns the parent of an asset, nil when it isn't set so it's
+// left out of the request and a parent set outside Terraform is kept
+func convertParent(d *schema.ResourceData) *ResourceId {
+	parent := d.Get("parent").(string)
+	if parent == "" {
+		return nil
+	}
+	return &ResourceId{Id: parent}
 }
 
 func (m *Asset) GetId() string {
@@ -35,6 +48,38 @@ func (m *Asset) ResourcePath() string {
 	return "v3/engine/asset/assets/"
 }
 
+func (m *Asset) GetKindFields() *AssetExtras {
+	return &m.KindFields
+}
+
+// LoadExtras reads the extras and the fields created for the kind kept in
+// the state, so they can be refreshed
+func (m *Asset) LoadExtras(d *schema.ResourceData) error {
+	return m.extrasFromSchema(d)
+}
+
+// extrasFromSchema reads the declared extras and the fields created for the
+// kind. A field moved from one to the other keeps its id.
+func (m *Asset) extrasFromSchema(d *schema.ResourceData) error {
+	if err := m.attributesFromSchema(d, "extra_attribute", "kind_attribute"); err != nil {
+		return err
+	}
+	if err := m.metadataFromSchema(d, "extra_metadata", "kind_metadata"); err != nil {
+		return err
+	}
+	if err := m.KindFields.attributesFromSchema(d, "kind_attribute", "extra_attribute"); err != nil {
+		return err
+	}
+	if err := m.KindFields.metadataFromSchema(d, "kind_metadata", "extra_metadata"); err != nil {
+		return err
+	}
+
+	m.AssetExtras.keepIds(m.KindFields.ids())
+	m.KindFields.keepIds(m.AssetExtras.ids())
+
+	return nil
+}
+
 func (m *Asset) FromSchema(d *schema.ResourceData) error {
 	m.Id = d.Id()
 
@@ -45,11 +90,13 @@ func (m *Asset) FromSchema(d *schema.ResourceData) error {
 	custom_timezone := d.Get("custom_timezone").(string)
 	geometryStr := d.Get("geometry").(string)
 
-	// Validate geometry JSON if it's set
+	// Reproject to WGS84, validate and normalize the geometry if it's set
 	if geometryStr != "" {
-		if err := validateJSONString(geometryStr); err != nil {
-			return fmt.Errorf("geometry must be a JSON encoded GeoJSON")
+		normalized, err := NormalizeGeometryCRS(geometryStr, d.Get("geometry_crs").(string))
+		if err != nil {
+			return fmt.Errorf("geometry must be a valid GeoJSON: %w", err)
 		}
+		geometryStr = normalized
 	}
 
 	// Check if geometryStr is empty and handle accordingly
@@ -68,9 +115,10 @@ func (m *Asset) FromSchema(d *schema.ResourceData) error {
 		UseCustomTimezone: custom_timezone != "",
 		Tags:              tags,
 		Kind:              kind,
+		Parent:            convertParent(d),
 	}
 
-	return nil
+	return m.extrasFromSchema(d)
 }
 
 func (m *Asset) ToSchema(d *schema.ResourceData) error {
@@ -108,5 +156,16 @@ func (m *Asset) ToSchema(d *schema.ResourceData) error {
 		})
 	}
 
+	if m.Parent != nil {
+		d.Set("parent", m.Parent.Id)
+	} else {
+		d.Set("parent", "")
+	}
+
+	m.extrasToSchema(d)
+	m.KindFields.attributesToSchema(d, "kind_attribute")
+	m.KindFields.metadataToSchema(d, "kind_metadata")
+	setAssetReferences(d, &m.AssetParams, &m.AssetExtras, &m.KindFields)
+
 	return nil
 }
@@ -1,6 +1,9 @@
 package models
 
 import (
+	"reflect"
+	"strings"
+
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -40,6 +43,15 @@ func (m *AssetAttribute) ResourcePath() string {
 	return "v3/engine/asset/attributes/"
 }
 
+// AssetAttributeList are attributes as listed, of any asset
+type AssetAttributeList struct {
+	Attributes []AssetAttribute `json:"results"`
+}
+
+func (m *AssetAttributeList) ResourcePath() string {
+	return "v3/engine/asset/attributes/"
+}
+
 func convertAssetAttribute(data []any) *AssetAttribute {
 	if len(data) == 0 {
 		return nil
@@ -79,3 +91,67 @@ func (m *AssetAttribute) ToSchema(d *schema.ResourceData) error {
 
 	return nil
 }
+
+// assetReferences are the nested attributes or metadata of a typed asset,
+// their ids, types and units keyed by name
+type assetReferences struct {
+	ids   map[string]any
+	types map[string]any
+	units map[string]any
+}
+
+func newAssetReferences() assetReferences {
+	return assetReferences{ids: map[string]any{}, types: map[string]any{}, units: map[string]any{}}
+}
+
+func (r assetReferences) add(id, name, kind, unit string) {
+	if id == "" {
+		return
+	}
+	r.ids[name] = id
+	r.types[name] = kind
+	if unit != "" {
+		r.units[name] = unit
+	}
+}
+
+// setAssetReferences sets the attributes and metadata maps of a typed asset,
+// the ids of its nested attributes and metadata keyed by name, along with the
+// attribute_types, attribute_units, metadata_types and metadata_units maps.
+// They're found by type among the fields of its params, named after their
+// JSON tags, and the extra ones managed along with the asset are added by
+// their own name.
+func setAssetReferences(d *schema.ResourceData, params any, extras ...*AssetExtras) {
+	attributes := newAssetReferences()
+	metadata := newAssetReferences()
+
+	value := reflect.Indirect(reflect.ValueOf(params))
+	for i := 0; i < value.NumField(); i++ {
+		name, _, _ := strings.Cut(value.Type().Field(i).Tag.Get("json"), ",")
+
+		switch field := value.Field(i).Interface().(type) {
+		case *AssetAttribute:
+			if field != nil {
+				attributes.add(field.Id, name, field.Type, field.Unit)
+			}
+		case AssetMetadata:
+			metadata.add(field.Id, name, field.Type, field.Unit)
+		}
+	}
+
+	for _, e := range extras {
+		for _, attribute := range e.ExtraAttributes {
+			attributes.add(attribute.Id, attribute.Name, attribute.Type, attribute.Unit)
+		}
+		for _, item := range e.ExtraMetadata {
+			metadata.add(item.Id, item.Name, item.Type, item.Unit)
+		}
+	}
+
+	d.Set("attributes", attributes.ids)
+	d.Set("attribute_types", attributes.types)
+	d.Set("attribute_units", attributes.units)
+	d.Set("metadata", metadata.ids)
+	d.Set("metadata_types", metadata.types)
+	d.Set("metadata_units", metadata.units)
+}
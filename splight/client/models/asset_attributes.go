@@ -0,0 +1,60 @@
+package models
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// AssetAttributes is a collection of attributes of an asset managed as a
+// whole. It has no endpoint of its own, each attribute is saved on its own.
+type AssetAttributes struct {
+	Asset string
+	AssetExtras
+}
+
+func (m *AssetAttributes) GetId() string {
+	return m.Asset
+}
+
+func (m *AssetAttributes) GetAsset() string {
+	return m.Asset
+}
+
+// CollectionBlock returns the attribute block. The unit of an attribute
+// can't be updated, it's created again.
+func (m *AssetAttributes) CollectionBlock() (string, []string) {
+	return "attribute", []string{"unit"}
+}
+
+func (m *AssetAttributes) GetParams() Params {
+	return nil
+}
+
+func (m *AssetAttributes) ResourcePath() string {
+	return "v3/engine/asset/attributes/"
+}
+
+func (m *AssetAttributes) FromSchema(d *schema.ResourceData) error {
+	m.Asset = d.Get("asset").(string)
+
+	return m.attributesFromSchema(d, "attribute")
+}
+
+// LoadExtras reads the attributes kept in the state, so they can be refreshed
+func (m *AssetAttributes) LoadExtras(d *schema.ResourceData) error {
+	return m.FromSchema(d)
+}
+
+func (m *AssetAttributes) ToSchema(d *schema.ResourceData) error {
+	d.SetId(m.Asset)
+
+	d.Set("asset", m.Asset)
+	m.attributesToSchema(d, "attribute")
+
+	ids := map[string]any{}
+	for _, attribute := range m.ExtraAttributes {
+		ids[attribute.Name] = attribute.Id
+	}
+	d.Set("ids", ids)
+
+	return nil
+}
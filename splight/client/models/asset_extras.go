@@ -0,0 +1,355 @@
+package models
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// AssetExtras are the attributes and metadata a typed asset manages on top of
+// its fixed platform ones. They aren't part of the asset payload, they are
+// reconciled through the attribute and metadata endpoints once the asset exists.
+type AssetExtras struct {
+	ExtraAttributes []AssetAttribute `json:"-"`
+	ExtraMetadata   []ExtraMetadata  `json:"-"`
+
+	// Ids of the extras removed from the configuration, to be deleted
+	StaleAttributes []string `json:"-"`
+	StaleMetadata   []string `json:"-"`
+
+	// Ids of the extras that are the same as in the state
+	unchanged map[string]bool
+}
+
+// ExtraMetadata is an extra metadata along with the field its value is set in
+type ExtraMetadata struct {
+	AssetMetadata
+
+	// Which of value, number_value, string_value, bool_value or quantity is set
+	valueKey string
+	// Quantities and their unit are kept as configured, the platform only
+	// stores the converted number
+	quantity       string
+	configuredUnit string
+}
+
+// ExtrasProvider is implemented by the typed assets that embed AssetExtras
+type ExtrasProvider interface {
+	GetExtras() *AssetExtras
+	LoadExtras(d *schema.ResourceData) error
+}
+
+// KindFieldsProvider is implemented by the assets that create the fields
+// their kind requires and that weren't declared
+type KindFieldsProvider interface {
+	GetKindFields() *AssetExtras
+}
+
+// AssetCollection is implemented by the models that manage a collection of
+// attributes or metadata of an asset, which have no endpoint of their own
+type AssetCollection interface {
+	ExtrasProvider
+	GetAsset() string
+	// CollectionBlock returns the block the items are configured in, and
+	// the fields of an item that can't be updated, so it's created again
+	CollectionBlock() (string, []string)
+}
+
+func (e *AssetExtras) GetExtras() *AssetExtras {
+	return e
+}
+
+// Unchanged reports whether an extra is the same as in the state, so it
+// doesn't need to be saved
+func (e *AssetExtras) Unchanged(id string) bool {
+	return e.unchanged[id]
+}
+
+// markUnchanged records an extra as unchanged if the given fields of its
+// item are the same as in the state
+func (e *AssetExtras) markUnchanged(id string, old, values map[string]any, keys ...string) {
+	if id == "" {
+		return
+	}
+	for _, key := range keys {
+		if old[key] != values[key] {
+			return
+		}
+	}
+	if e.unchanged == nil {
+		e.unchanged = map[string]bool{}
+	}
+	e.unchanged[id] = true
+}
+
+// SetAsset links every extra to the asset they belong to
+func (e *AssetExtras) SetAsset(asset string) {
+	for i := range e.ExtraAttributes {
+		e.ExtraAttributes[i].Asset = asset
+	}
+	for i := range e.ExtraMetadata {
+		e.ExtraMetadata[i].Asset = asset
+	}
+}
+
+// previousItems returns the items of a block in the previous state by name.
+// Items are matched by name rather than position, so reordering or removing
+// one doesn't recreate the others.
+func previousItems(d *schema.ResourceData, block string) map[string]map[string]any {
+	old, _ := d.GetChange(block)
+
+	items := map[string]map[string]any{}
+	for _, item := range old.([]any) {
+		if values, ok := item.(map[string]any); ok && values["id"] != "" {
+			items[values["name"].(string)] = values
+		}
+	}
+	return items
+}
+
+// reusableItem returns the previous item of a name, looking in the block
+// first and then in the ones it may have been moved from
+func reusableItem(d *schema.ResourceData, previous map[string]map[string]any, name string, fallbacks []string) (map[string]any, bool) {
+	if old, ok := previous[name]; ok {
+		return old, true
+	}
+	for _, block := range fallbacks {
+		if old, ok := previousItems(d, block)[name]; ok {
+			return old, true
+		}
+	}
+	return nil, false
+}
+
+// ids returns the ids of every extra
+func (e *AssetExtras) ids() map[string]bool {
+	ids := map[string]bool{}
+	for _, attribute := range e.ExtraAttributes {
+		ids[attribute.Id] = true
+	}
+	for _, item := range e.ExtraMetadata {
+		ids[item.Id] = true
+	}
+	return ids
+}
+
+// keepIds removes the given ids from the stale ones, when they've been moved
+// to another block rather than removed
+func (e *AssetExtras) keepIds(ids map[string]bool) {
+	var attributes, metadata []string
+	for _, id := range e.StaleAttributes {
+		if !ids[id] {
+			attributes = append(attributes, id)
+		}
+	}
+	for _, id := range e.StaleMetadata {
+		if !ids[id] {
+			metadata = append(metadata, id)
+		}
+	}
+	e.StaleAttributes = attributes
+	e.StaleMetadata = metadata
+}
+
+// staleIds returns the ids of the previous items that are no longer used
+func staleIds(previous map[string]map[string]any, used map[string]string) []string {
+	var stale []string
+	for name, values := range previous {
+		if id := values["id"].(string); used[name] != id {
+			stale = append(stale, id)
+		}
+	}
+	return stale
+}
+
+// extrasFromSchema reads the extra_attribute and extra_metadata blocks
+func (e *AssetExtras) extrasFromSchema(d *schema.ResourceData) error {
+	if err := e.attributesFromSchema(d, "extra_attribute"); err != nil {
+		return err
+	}
+	return e.metadataFromSchema(d, "extra_metadata")
+}
+
+// attributesFromSchema reads the attributes of a block, keyed by name. Items
+// moved from the fallback blocks keep their ids.
+func (e *AssetExtras) attributesFromSchema(d *schema.ResourceData, block string, fallbacks ...string) error {
+	previous := previousItems(d, block)
+	used := map[string]string{}
+	e.ExtraAttributes = nil
+	for _, item := range d.Get(block).([]any) {
+		values := item.(map[string]any)
+		name := values["name"].(string)
+		if _, ok := used[name]; ok {
+			return fmt.Errorf("%s %q is defined more than once", block, name)
+		}
+
+		// The unit of an attribute can't be updated, it's created again
+		var id string
+		if old, ok := reusableItem(d, previous, name, fallbacks); ok && old["unit"] == values["unit"] {
+			id = old["id"].(string)
+			e.markUnchanged(id, old, values, "type")
+		}
+		used[name] = id
+
+		e.ExtraAttributes = append(e.ExtraAttributes, AssetAttribute{
+			AssetAttributeParams: AssetAttributeParams{
+				Name: name,
+				Type: values["type"].(string),
+				Unit: values["unit"].(string),
+			},
+			Id: id,
+		})
+	}
+	e.StaleAttributes = staleIds(previous, used)
+
+	return nil
+}
+
+// metadataFromSchema reads the metadata of a block, keyed by name. Items
+// moved from the fallback blocks keep their ids.
+func (e *AssetExtras) metadataFromSchema(d *schema.ResourceData, block string, fallbacks ...string) error {
+	previous := previousItems(d, block)
+	used := map[string]string{}
+	e.ExtraMetadata = nil
+
+	var configItems []cty.Value
+	if value, ok := configAttribute(d.GetRawConfig(), block); ok && value.CanIterateElements() {
+		configItems = value.AsValueSlice()
+	}
+
+	for i, item := range d.Get(block).([]any) {
+		values := item.(map[string]any)
+		name := values["name"].(string)
+		if _, ok := used[name]; ok {
+			return fmt.Errorf("%s %q is defined more than once", block, name)
+		}
+
+		var id string
+		if old, ok := reusableItem(d, previous, name, fallbacks); ok {
+			id = old["id"].(string)
+			e.markUnchanged(id, old, values, "type", "unit", "value", "number_value", "string_value", "bool_value", "quantity")
+		}
+		used[name] = id
+
+		metadataType := values["type"].(string)
+		unit := values["unit"].(string)
+
+		// Only the configuration tells whether more than one value is set
+		key := extraMetadataValueKey(values)
+		if i < len(configItems) {
+			var err error
+			if key, err = metadataValueKey(configItems[i]); err != nil {
+				return fmt.Errorf("%s %q: %w", block, name, err)
+			}
+		}
+
+		value, valueType, valueUnit, err := encodeMetadataValue(key, values, unit)
+		if err != nil {
+			return fmt.Errorf("%s %q: %w", block, name, err)
+		}
+		if valueType != "" && valueType != metadataType {
+			return fmt.Errorf("%s %q: %s requires type %s, got %s", block, name, key, valueType, metadataType)
+		}
+		if valueUnit == "" {
+			valueUnit = unit
+		}
+
+		e.ExtraMetadata = append(e.ExtraMetadata, ExtraMetadata{
+			AssetMetadata: AssetMetadata{
+				AssetMetadataParams: AssetMetadataParams{
+					Name:  name,
+					Type:  metadataType,
+					Value: value,
+					Unit:  valueUnit,
+				},
+				Id: id,
+			},
+			valueKey:       key,
+			quantity:       values["quantity"].(string),
+			configuredUnit: unit,
+		})
+	}
+	e.StaleMetadata = staleIds(previous, used)
+
+	return nil
+}
+
+// extraMetadataValueKey picks the field an extra metadata value is set in
+// from the state. Only that field is kept, so a zero value means the typed
+// field that matches the metadata type.
+func extraMetadataValueKey(values map[string]any) string {
+	switch {
+	case values["quantity"] != "":
+		return "quantity"
+	case values["value"] != "":
+		return "value"
+	case values["string_value"] != "":
+		return "string_value"
+	case values["number_value"] != 0.0:
+		return "number_value"
+	case values["bool_value"] == true:
+		return "bool_value"
+	}
+
+	if key, ok := metadataTypedKeys[values["type"].(string)]; ok {
+		return key
+	}
+	return "value"
+}
+
+// LoadExtras reads the extras kept in the state, so they can be refreshed
+func (e *AssetExtras) LoadExtras(d *schema.ResourceData) error {
+	return e.extrasFromSchema(d)
+}
+
+// extrasToSchema writes the extra_attribute and extra_metadata blocks
+func (e *AssetExtras) extrasToSchema(d *schema.ResourceData) {
+	e.attributesToSchema(d, "extra_attribute")
+	e.metadataToSchema(d, "extra_metadata")
+}
+
+// attributesToSchema writes the attributes to a block
+func (e *AssetExtras) attributesToSchema(d *schema.ResourceData, block string) {
+	attributes := make([]map[string]any, len(e.ExtraAttributes))
+	for i, attribute := range e.ExtraAttributes {
+		attributes[i] = map[string]any{
+			"id":   attribute.Id,
+			"name": attribute.Name,
+			"type": attribute.Type,
+			"unit": attribute.Unit,
+		}
+	}
+	d.Set(block, attributes)
+}
+
+// metadataToSchema writes the metadata to a block
+func (e *AssetExtras) metadataToSchema(d *schema.ResourceData, block string) {
+	metadata := make([]map[string]any, len(e.ExtraMetadata))
+	for i, item := range e.ExtraMetadata {
+		values := map[string]any{
+			"id":   item.Id,
+			"name": item.Name,
+			"type": item.Type,
+			"unit": item.Unit,
+		}
+
+		// Only the field the value was set in is kept, so it matches the configuration
+		switch item.valueKey {
+		case "quantity":
+			values["quantity"] = item.quantity
+			values["unit"] = item.configuredUnit
+		case "value":
+			values["value"] = string(item.Value)
+		default:
+			if typed, ok := item.typedValue()[item.valueKey]; ok {
+				values[item.valueKey] = typed
+			} else {
+				// The value no longer matches its field, show it as JSON
+				values["value"] = string(item.Value)
+			}
+		}
+		metadata[i] = values
+	}
+	d.Set(block, metadata)
+}
@@ -1,6 +1,10 @@
 package models
 
-import "github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+import (
+	"encoding/json"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
 
 type AssetKinds struct {
 	Kinds []QueryFilter `json:"results"`
@@ -26,3 +30,111 @@ func (m *AssetKinds) ToSchema(d *schema.ResourceData) error {
 
 	return nil
 }
+
+// AssetKindField is an attribute or metadata an asset kind expects
+type AssetKindField struct {
+	Name     string          `json:"name"`
+	Type     string          `json:"type"`
+	Unit     string          `json:"unit,omitempty"`
+	Required bool            `json:"required"`
+	Value    json.RawMessage `json:"value,omitempty"` // Default value of a metadata
+}
+
+func (m *AssetKindField) toMap() map[string]any {
+	// Templates without a default are echoed as null, which is left empty
+	value := ""
+	if len(m.Value) > 0 && string(m.Value) != "null" {
+		value = string(m.Value)
+	}
+
+	return map[string]any{
+		"name":     m.Name,
+		"type":     m.Type,
+		"unit":     m.Unit,
+		"required": m.Required,
+		"value":    value,
+	}
+}
+
+type AssetKindParams struct {
+	Name        string           `json:"name"`
+	Description string           `json:"description"`
+	Icon        string           `json:"icon,omitempty"`
+	Attributes  []AssetKindField `json:"attributes"`
+	Metadata    []AssetKindField `json:"metadata"`
+}
+
+// AssetKind is the definition of an asset kind, with the attributes and
+// metadata the assets of that kind are expected to have
+type AssetKind struct {
+	AssetKindParams
+	Id string `json:"id"`
+}
+
+func (m *AssetKind) GetId() string {
+	return m.Id
+}
+
+func (m *AssetKind) GetParams() Params {
+	return &m.AssetKindParams
+}
+
+func (m *AssetKind) ResourcePath() string {
+	return "v3/engine/asset/kinds/"
+}
+
+// convertAssetKindFields reads the attribute or metadata templates of a kind
+func convertAssetKindFields(data []any) []AssetKindField {
+	fields := make([]AssetKindField, len(data))
+	for i, item := range data {
+		values := item.(map[string]any)
+
+		fields[i] = AssetKindField{
+			Name:     values["name"].(string),
+			Type:     values["type"].(string),
+			Unit:     values["unit"].(string),
+			Required: values["required"].(bool),
+		}
+		if value, _ := values["value"].(string); value != "" {
+			fields[i].Value = json.RawMessage(value)
+		}
+	}
+	return fields
+}
+
+func (m *AssetKind) FromSchema(d *schema.ResourceData) error {
+	m.Id = d.Id()
+
+	m.AssetKindParams = AssetKindParams{
+		Name:        d.Get("name").(string),
+		Description: d.Get("description").(string),
+		Icon:        d.Get("icon").(string),
+		Attributes:  convertAssetKindFields(d.Get("attributes").([]any)),
+		Metadata:    convertAssetKindFields(d.Get("metadata").([]any)),
+	}
+
+	return nil
+}
+
+func (m *AssetKind) ToSchema(d *schema.ResourceData) error {
+	d.SetId(m.Id)
+
+	d.Set("name", m.Name)
+	d.Set("description", m.Description)
+	d.Set("icon", m.Icon)
+
+	attributes := make([]map[string]any, len(m.Attributes))
+	for i, attribute := range m.Attributes {
+		attributes[i] = attribute.toMap()
+		delete(attributes[i], "value")
+	}
+	d.Set("attributes", attributes)
+
+	metadata := make([]map[string]any, len(m.Metadata))
+	for i, item := range m.Metadata {
+		metadata[i] = item.toMap()
+	}
+	d.Set("metadata", metadata)
+
+	return nil
+}
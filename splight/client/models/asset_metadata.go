@@ -3,8 +3,13 @@ package models
 import (
 	"encoding/json"
 	"fmt"
+	"strconv"
+	"strings"
 
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/splightplatform/terraform-provider-splight/splight/electrical"
+	"github.com/splightplatform/terraform-provider-splight/splight/units"
 )
 
 type AssetMetadataParams struct {
@@ -35,35 +40,153 @@ func (m *AssetMetadata) ToMap() map[string]any {
 		"name":  m.Name,
 		"type":  m.Type,
 		"unit":  m.Unit,
+		"value": string(m.Value),
 	}
 
-	// NOTE: convert floats to ints without loosing decimals.
-	// This is done because API casts the values
-	var rawValue any
-	if err := json.Unmarshal(m.Value, &rawValue); err == nil {
-		// Check if the value is a float
-		if floatVal, ok := rawValue.(float64); ok {
-			// Check if the float can be converted to an integer without loss
-			if float64(int(floatVal)) == floatVal {
-				result["value"] = int(floatVal)
-			} else {
-				result["value"] = string(m.Value)
-			}
-		} else {
-			result["value"] = string(m.Value)
-		}
-	} else {
-		result["value"] = string(m.Value)
+	for key, value := range m.typedValue() {
+		result[key] = value
 	}
 
 	return result
 }
 
+// typedValue decodes the value into the typed field that matches the
+// metadata type. Values that don't match their type are only kept as JSON.
+func (m *AssetMetadata) typedValue() map[string]any {
+	var err error
+	var value any
+
+	switch m.Type {
+	case "Number":
+		var number float64
+		err = json.Unmarshal(m.Value, &number)
+		value = number
+	case "String":
+		var str string
+		err = json.Unmarshal(m.Value, &str)
+		value = str
+	case "Boolean":
+		var boolean bool
+		err = json.Unmarshal(m.Value, &boolean)
+		value = boolean
+	default:
+		return nil
+	}
+
+	if err != nil {
+		return nil
+	}
+
+	return map[string]any{metadataTypedKeys[m.Type]: value}
+}
+
 func (m *AssetMetadata) ResourcePath() string {
 	return "v3/engine/asset/metadata/"
 }
 
-func convertAssetMetadata(data []any) (*AssetMetadata, error) {
+// metadataTypedKeys maps each metadata type to the field holding its typed value
+var metadataTypedKeys = map[string]string{
+	"Number":  "number_value",
+	"String":  "string_value",
+	"Boolean": "bool_value",
+}
+
+func encodeNumber(value float64) json.RawMessage {
+	return json.RawMessage(strconv.FormatFloat(value, 'f', -1, 64))
+}
+
+// metadataValueKey returns which of value, number_value, string_value,
+// bool_value or quantity is set in the configuration. Typed values are also kept in the
+// state, so only the configuration tells which one the user chose.
+func metadataValueKey(config cty.Value) (string, error) {
+	var keys []string
+	for _, key := range []string{"value", "number_value", "string_value", "bool_value", "quantity"} {
+		if _, ok := configAttribute(config, key); ok {
+			keys = append(keys, key)
+		}
+	}
+
+	switch len(keys) {
+	case 0:
+		return "", fmt.Errorf("one of value, number_value, string_value, bool_value or quantity must be set")
+	case 1:
+		return keys[0], nil
+	default:
+		return "", fmt.Errorf("only one of value, number_value, string_value, bool_value or quantity can be set, got %s", strings.Join(keys, ", "))
+	}
+}
+
+// stateMetadataValueKey picks the field of a metadata value when there is no
+// configuration to tell which one was set. The JSON value is always in the
+// state, so a typed value is only used when it is the only one.
+func stateMetadataValueKey(values map[string]any) string {
+	if values["value"] != "" {
+		return "value"
+	}
+	if values["string_value"] != "" {
+		return "string_value"
+	}
+	if values["number_value"] != 0.0 {
+		return "number_value"
+	}
+	if values["bool_value"] == true {
+		return "bool_value"
+	}
+	if quantity, _ := values["quantity"].(string); quantity != "" {
+		return "quantity"
+	}
+	return "value"
+}
+
+// convertQuantity parses a value with its unit, e.g. "12.3 km", and converts
+// it to the given unit. Without a unit, the value is kept in its own unit.
+func convertQuantity(quantity, unit string) (float64, string, error) {
+	value, quantityUnit, err := units.ParseQuantity(quantity)
+	if err != nil {
+		return 0, "", err
+	}
+	if unit == "" {
+		return value, quantityUnit.Symbol, nil
+	}
+
+	converted, err := units.Convert(value, quantityUnit.Symbol, unit)
+	if err != nil {
+		return 0, "", err
+	}
+	return converted, unit, nil
+}
+
+// encodeMetadataValue returns the JSON encoded value with the metadata type
+// and unit implied by the field it was set in. Quantities are converted to
+// the given unit. Type and unit are empty when the field doesn't imply them.
+func encodeMetadataValue(key string, values map[string]any, unit string) (json.RawMessage, string, string, error) {
+	switch key {
+	case "number_value":
+		return encodeNumber(values[key].(float64)), "Number", "", nil
+	case "string_value":
+		encoded, err := json.Marshal(values[key].(string))
+		return encoded, "String", "", err
+	case "bool_value":
+		encoded, err := json.Marshal(values[key].(bool))
+		return encoded, "Boolean", "", err
+	case "quantity":
+		value, valueUnit, err := convertQuantity(values[key].(string), unit)
+		if err != nil {
+			return nil, "", "", err
+		}
+		return encodeNumber(value), "Number", valueUnit, nil
+	}
+
+	valueStr := values["value"].(string)
+	if err := validateJSONString(valueStr); err != nil {
+		return nil, "", "", fmt.Errorf("metadata value must be JSON encoded")
+	}
+	return json.RawMessage(valueStr), "", "", nil
+}
+
+func convertAssetMetadata(d *schema.ResourceData, block string) (*AssetMetadata, error) {
+	data := d.Get(block).(*schema.Set).List()
+
 	// Handle empty input
 	if len(data) == 0 {
 		return &AssetMetadata{AssetMetadataParams: AssetMetadataParams{}}, nil
@@ -72,10 +195,25 @@ func convertAssetMetadata(data []any) (*AssetMetadata, error) {
 	// Type assertion for metadata map
 	metadataMap := data[0].(map[string]any)
 
-	// Extract value and validate JSON
-	valueStr := metadataMap["value"].(string)
-	if err := validateJSONString(valueStr); err != nil {
-		return nil, fmt.Errorf("metadata value must be JSON encoded")
+	// Blocks missing from the configuration keep the value in the state
+	key := stateMetadataValueKey(metadataMap)
+	if item, ok := configBlockItem(d.GetRawConfig(), block); ok {
+		var err error
+		if key, err = metadataValueKey(item); err != nil {
+			return nil, err
+		}
+	}
+
+	// Quantities are normalized to the unit the platform expects, if known
+	value, valueType, valueUnit, err := encodeMetadataValue(key, metadataMap, electrical.MetadataUnits[block])
+	if err != nil {
+		return nil, err
+	}
+	if valueType == "" {
+		valueType = metadataMap["type"].(string)
+	}
+	if valueUnit == "" {
+		valueUnit = metadataMap["unit"].(string)
 	}
 
 	// Construct and return AssetMetadata
@@ -83,9 +221,9 @@ func convertAssetMetadata(data []any) (*AssetMetadata, error) {
 		AssetMetadataParams: AssetMetadataParams{
 			Asset: metadataMap["asset"].(string),
 			Name:  metadataMap["name"].(string),
-			Type:  metadataMap["type"].(string),
-			Value: json.RawMessage(valueStr),
-			Unit:  metadataMap["unit"].(string),
+			Type:  valueType,
+			Value: value,
+			Unit:  valueUnit,
 		},
 		Id: metadataMap["id"].(string),
 	}, nil
@@ -94,18 +232,41 @@ func convertAssetMetadata(data []any) (*AssetMetadata, error) {
 func (m *AssetMetadata) FromSchema(d *schema.ResourceData) error {
 	m.Id = d.Id()
 
-	// Validate value JSON
-	valueStr := d.Get("value").(string)
-	if err := validateJSONString(valueStr); err != nil {
-		return fmt.Errorf("metadata value must be JSON encoded")
+	values := map[string]any{
+		"value":        d.Get("value"),
+		"number_value": d.Get("number_value"),
+		"string_value": d.Get("string_value"),
+		"bool_value":   d.Get("bool_value"),
+		"quantity":     d.Get("quantity"),
+	}
+
+	key := stateMetadataValueKey(values)
+	if config := d.GetRawConfig(); !config.IsNull() {
+		var err error
+		if key, err = metadataValueKey(config); err != nil {
+			return err
+		}
+	}
+	// Quantities are converted to the unit of the metadata, if set
+	value, valueType, valueUnit, err := encodeMetadataValue(key, values, d.Get("unit").(string))
+	if err != nil {
+		return err
+	}
+	if valueUnit == "" {
+		valueUnit = d.Get("unit").(string)
+	}
+
+	metadataType := d.Get("type").(string)
+	if valueType != "" && valueType != metadataType {
+		return fmt.Errorf("%s requires type %s, got %s", key, valueType, metadataType)
 	}
 
 	m.AssetMetadataParams = AssetMetadataParams{
 		Asset: d.Get("asset").(string),
 		Name:  d.Get("name").(string),
-		Type:  d.Get("type").(string),
-		Value: json.RawMessage(valueStr),
-		Unit:  d.Get("unit").(string),
+		Type:  metadataType,
+		Value: value,
+		Unit:  valueUnit,
 	}
 
 	return nil
@@ -117,8 +278,12 @@ func (m *AssetMetadata) ToSchema(d *schema.ResourceData) error {
 	d.Set("asset", m.Asset)
 	d.Set("name", m.Name)
 	d.Set("type", m.Type)
-	d.Set("value", m.Value)
+	d.Set("value", string(m.Value))
 	d.Set("unit", m.Unit)
 
+	for key, value := range m.typedValue() {
+		d.Set(key, value)
+	}
+
 	return nil
 }
@@ -0,0 +1,60 @@
+package models
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// AssetMetadataSet is a collection of metadata of an asset managed as a
+// whole. It has no endpoint of its own, each metadata is saved on its own.
+type AssetMetadataSet struct {
+	Asset string
+	AssetExtras
+}
+
+func (m *AssetMetadataSet) GetId() string {
+	return m.Asset
+}
+
+func (m *AssetMetadataSet) GetAsset() string {
+	return m.Asset
+}
+
+// CollectionBlock returns the metadata block, every field of a metadata can
+// be updated
+func (m *AssetMetadataSet) CollectionBlock() (string, []string) {
+	return "metadata", nil
+}
+
+func (m *AssetMetadataSet) GetParams() Params {
+	return nil
+}
+
+func (m *AssetMetadataSet) ResourcePath() string {
+	return "v3/engine/asset/metadata/"
+}
+
+func (m *AssetMetadataSet) FromSchema(d *schema.ResourceData) error {
+	m.Asset = d.Get("asset").(string)
+
+	return m.metadataFromSchema(d, "metadata")
+}
+
+// LoadExtras reads the metadata kept in the state, so they can be refreshed
+func (m *AssetMetadataSet) LoadExtras(d *schema.ResourceData) error {
+	return m.FromSchema(d)
+}
+
+func (m *AssetMetadataSet) ToSchema(d *schema.ResourceData) error {
+	d.SetId(m.Asset)
+
+	d.Set("asset", m.Asset)
+	m.metadataToSchema(d, "metadata")
+
+	ids := map[string]any{}
+	for _, item := range m.ExtraMetadata {
+		ids[item.Name] = item.Id
+	}
+	d.Set("ids", ids)
+
+	return nil
+}
@@ -38,7 +38,7 @@ func (m *AssetRelation) FromSchema(d *schema.ResourceData) error {
 	relatedAsset := d.Get("related_asset").(*schema.Set).List()
 
 	var parsedRelatedAsset *QueryFilter = nil
-	if len(relatedAsset) == 0 {
+	if len(relatedAsset) > 0 {
 		parsedRelatedAsset = convertSingleQueryFilter(relatedAsset)
 	}
 
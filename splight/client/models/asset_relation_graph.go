@@ -0,0 +1,171 @@
+package models
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// AssetRelations are the relations that start or end at an asset
+type AssetRelations struct {
+	Relations []AssetRelation `json:"results"`
+}
+
+func (m *AssetRelations) ResourcePath() string {
+	return "v3/engine/asset/relations/"
+}
+
+// AssetRelationEdge is a relation found while walking the graph, along with
+// how many relations away from the root it is
+type AssetRelationEdge struct {
+	AssetRelation
+	Depth int
+}
+
+// AssetRelationGraph is every relation reachable from a root asset, breadth
+// first, following them in the given direction down to a maximum depth. A
+// depth of 0 means the whole connected graph.
+type AssetRelationGraph struct {
+	Root      string
+	MaxDepth  int
+	Direction string
+	Edges     []AssetRelationEdge
+}
+
+// Follows reports whether relations are followed in a direction, "outgoing"
+// from the asset they start at or "incoming" from the related asset
+func (m *AssetRelationGraph) Follows(direction string) bool {
+	return m.Direction == "both" || m.Direction == direction
+}
+
+func (m *AssetRelationGraph) FromSchema(d *schema.ResourceData) error {
+	m.Root = d.Get("asset").(string)
+	m.MaxDepth = d.Get("max_depth").(int)
+	m.Direction = d.Get("direction").(string)
+
+	return nil
+}
+
+// relatedAssetId returns the id of the target of a relation, empty when it
+// only targets a kind
+func (e *AssetRelationEdge) relatedAssetId() string {
+	if e.RelatedAsset == nil {
+		return ""
+	}
+	return e.RelatedAsset.Id
+}
+
+// nodes returns the assets of the graph in the order they're found
+func (m *AssetRelationGraph) nodes() []QueryFilter {
+	seen := map[string]bool{}
+	var nodes []QueryFilter
+	add := func(asset *QueryFilter) {
+		if asset == nil || asset.Id == "" || seen[asset.Id] {
+			return
+		}
+		seen[asset.Id] = true
+		nodes = append(nodes, *asset)
+	}
+
+	for _, edge := range m.Edges {
+		add(&edge.Asset)
+		add(edge.RelatedAsset)
+	}
+	return nodes
+}
+
+// DOT renders the graph in the Graphviz DOT language, assets labelled with
+// their names and relations with theirs
+func (m *AssetRelationGraph) DOT() string {
+	var b strings.Builder
+	b.WriteString("digraph relations {\n")
+	for _, node := range m.nodes() {
+		fmt.Fprintf(&b, "  %s [label=%s];\n", strconv.Quote(node.Id), strconv.Quote(node.Name))
+	}
+	for _, edge := range m.Edges {
+		if id := edge.relatedAssetId(); id != "" {
+			fmt.Fprintf(&b, "  %s -> %s [label=%s];\n", strconv.Quote(edge.Asset.Id), strconv.Quote(id), strconv.Quote(edge.Name))
+		}
+	}
+	b.WriteString("}\n")
+	return b.String()
+}
+
+// JSON renders the graph as its nodes and edges, to be decoded with jsondecode
+func (m *AssetRelationGraph) JSON() (string, error) {
+	type node struct {
+		Id   string `json:"id"`
+		Name string `json:"name"`
+	}
+	type edge struct {
+		Id               string `json:"id"`
+		Name             string `json:"name"`
+		RelatedAssetKind string `json:"related_asset_kind"`
+		Asset            string `json:"asset"`
+		RelatedAsset     string `json:"related_asset"`
+		Depth            int    `json:"depth"`
+	}
+	graph := struct {
+		Nodes []node `json:"nodes"`
+		Edges []edge `json:"edges"`
+	}{
+		Nodes: []node{},
+		Edges: []edge{},
+	}
+
+	for _, n := range m.nodes() {
+		graph.Nodes = append(graph.Nodes, node{Id: n.Id, Name: n.Name})
+	}
+	for _, e := range m.Edges {
+		graph.Edges = append(graph.Edges, edge{
+			Id:               e.Id,
+			Name:             e.Name,
+			RelatedAssetKind: e.RelatedAssetKind.Name,
+			Asset:            e.Asset.Id,
+			RelatedAsset:     e.relatedAssetId(),
+			Depth:            e.Depth,
+		})
+	}
+
+	encoded, err := json.Marshal(graph)
+	if err != nil {
+		return "", err
+	}
+	return string(encoded), nil
+}
+
+func (m *AssetRelationGraph) ToSchema(d *schema.ResourceData) error {
+	d.SetId(m.Root)
+
+	relations := make([]map[string]any, len(m.Edges))
+	for i, edge := range m.Edges {
+		var relatedAssetName string
+		if edge.RelatedAsset != nil {
+			relatedAssetName = edge.RelatedAsset.Name
+		}
+		relations[i] = map[string]any{
+			"id":                 edge.Id,
+			"name":               edge.Name,
+			"description":        edge.Description,
+			"related_asset_kind": edge.RelatedAssetKind.Name,
+			"asset":              edge.Asset.Id,
+			"asset_name":         edge.Asset.Name,
+			"related_asset":      edge.relatedAssetId(),
+			"related_asset_name": relatedAssetName,
+			"depth":              edge.Depth,
+		}
+	}
+	d.Set("relations", relations)
+
+	encoded, err := m.JSON()
+	if err != nil {
+		return fmt.Errorf("error encoding relation graph: %w", err)
+	}
+	d.Set("json", encoded)
+	d.Set("dot", m.DOT())
+
+	return nil
+}
@@ -0,0 +1,69 @@
+package models
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// AssetNode is an asset as listed under its parent
+type AssetNode struct {
+	Id     string       `json:"id"`
+	Name   string       `json:"name"`
+	Kind   *QueryFilter `json:"kind"`
+	Parent *ResourceId  `json:"parent"`
+}
+
+// AssetChildren are the assets placed directly under a parent
+type AssetChildren struct {
+	Assets []AssetNode `json:"results"`
+}
+
+func (m *AssetChildren) ResourcePath() string {
+	return "v3/engine/asset/assets/"
+}
+
+// AssetSubtreeNode is an asset under the root of a subtree, along with how
+// many levels below the root it is
+type AssetSubtreeNode struct {
+	AssetNode
+	Depth int
+}
+
+// AssetSubtree is every asset under a root asset, breadth first, down to a
+// maximum depth. A depth of 0 means the whole subtree.
+type AssetSubtree struct {
+	Root     string
+	MaxDepth int
+	Nodes    []AssetSubtreeNode
+}
+
+func (m *AssetSubtree) FromSchema(d *schema.ResourceData) error {
+	m.Root = d.Get("asset").(string)
+	m.MaxDepth = d.Get("max_depth").(int)
+
+	return nil
+}
+
+func (m *AssetSubtree) ToSchema(d *schema.ResourceData) error {
+	d.SetId(m.Root)
+
+	assets := make([]map[string]any, len(m.Nodes))
+	for i, node := range m.Nodes {
+		var kind, parent string
+		if node.Kind != nil {
+			kind = node.Kind.Name
+		}
+		if node.Parent != nil {
+			parent = node.Parent.Id
+		}
+		assets[i] = map[string]any{
+			"id":     node.Id,
+			"name":   node.Name,
+			"kind":   kind,
+			"parent": parent,
+			"depth":  node.Depth,
+		}
+	}
+	d.Set("assets", assets)
+
+	return nil
+}
@@ -0,0 +1,153 @@
+package models
+
+import (
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// Assets are the assets that match a search. The platform lists every asset
+// and they're filtered here, by name, kind, tags, timezone and by the
+// attributes they have.
+type Assets struct {
+	Assets []Asset `json:"results"`
+
+	Name       string         `json:"-"`
+	NamePrefix string         `json:"-"`
+	NameRegex  *regexp.Regexp `json:"-"`
+	Kind       string         `json:"-"`
+	Tags       []string       `json:"-"`
+	AllTags    bool           `json:"-"`
+	Timezone   string         `json:"-"`
+	Attributes []string       `json:"-"`
+}
+
+func (m *Assets) ResourcePath() string {
+	return "v3/engine/asset/assets/"
+}
+
+func (m *Assets) FromSchema(d *schema.ResourceData) error {
+	m.Name = d.Get("name").(string)
+	m.NamePrefix = d.Get("name_prefix").(string)
+	m.Kind = d.Get("kind").(string)
+	m.AllTags = d.Get("tags_match").(string) == "all"
+	m.Timezone = d.Get("timezone").(string)
+
+	m.NameRegex = nil
+	if expression := d.Get("name_regex").(string); expression != "" {
+		compiled, err := regexp.Compile(expression)
+		if err != nil {
+			return fmt.Errorf("invalid name_regex: %w", err)
+		}
+		m.NameRegex = compiled
+	}
+
+	m.Tags = nil
+	for _, tag := range d.Get("tags").(*schema.Set).List() {
+		m.Tags = append(m.Tags, tag.(string))
+	}
+	m.Attributes = nil
+	for _, attribute := range d.Get("attributes").(*schema.Set).List() {
+		m.Attributes = append(m.Attributes, attribute.(string))
+	}
+
+	return nil
+}
+
+// hasTags reports whether an asset has any or all of the tags, as asked
+func (m *Assets) hasTags(asset Asset) bool {
+	if len(m.Tags) == 0 {
+		return true
+	}
+
+	has := func(name string) bool {
+		return slices.ContainsFunc(asset.Tags, func(tag QueryFilter) bool {
+			return tag.Name == name
+		})
+	}
+	if m.AllTags {
+		return !slices.ContainsFunc(m.Tags, func(name string) bool { return !has(name) })
+	}
+	return slices.ContainsFunc(m.Tags, has)
+}
+
+// effectiveTimezone returns the timezone an asset uses, its custom one if set
+func effectiveTimezone(asset Asset) string {
+	if asset.UseCustomTimezone {
+		return asset.CustomTimezone
+	}
+	return asset.Timezone
+}
+
+// matches reports whether an asset matches every filter but its attributes
+func (m *Assets) matches(asset Asset) bool {
+	switch {
+	case m.Name != "" && asset.Name != m.Name:
+		return false
+	case m.NamePrefix != "" && !strings.HasPrefix(asset.Name, m.NamePrefix):
+		return false
+	case m.NameRegex != nil && !m.NameRegex.MatchString(asset.Name):
+		return false
+	case m.Kind != "" && (asset.Kind == nil || asset.Kind.Name != m.Kind):
+		return false
+	case m.Timezone != "" && effectiveTimezone(asset) != m.Timezone:
+		return false
+	}
+	return m.hasTags(asset)
+}
+
+// Filter keeps the listed assets that match every filter but their
+// attributes, which are filtered with FilterByAttribute
+func (m *Assets) Filter() {
+	m.Assets = slices.DeleteFunc(m.Assets, func(asset Asset) bool {
+		return !m.matches(asset)
+	})
+}
+
+// FilterByAttribute keeps the assets among the ones with an attribute
+func (m *Assets) FilterByAttribute(assets map[string]bool) {
+	m.Assets = slices.DeleteFunc(m.Assets, func(asset Asset) bool {
+		return !assets[asset.Id]
+	})
+}
+
+func (m *Assets) ToSchema(d *schema.ResourceData) error {
+	assets := make([]map[string]any, len(m.Assets))
+	for i, asset := range m.Assets {
+		var kind []map[string]any
+		if asset.Kind != nil {
+			kind = []map[string]any{{
+				"id":   asset.Kind.Id,
+				"name": asset.Kind.Name,
+			}}
+		}
+
+		tags := make([]map[string]any, len(asset.Tags))
+		for j, tag := range asset.Tags {
+			tags[j] = map[string]any{
+				"id":   tag.Id,
+				"name": tag.Name,
+			}
+		}
+
+		var geometry string
+		if asset.Geometry != nil {
+			geometry = string(*asset.Geometry)
+		}
+
+		assets[i] = map[string]any{
+			"id":       asset.Id,
+			"name":     asset.Name,
+			"kind":     kind,
+			"tags":     tags,
+			"geometry": geometry,
+		}
+	}
+	d.Set("assets", assets)
+	d.SetId("assets")
+
+	return nil
+}
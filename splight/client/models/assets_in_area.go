@@ -0,0 +1,161 @@
+package models
+
+import (
+	"encoding/json"
+	"fmt"
+	"slices"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/splightplatform/terraform-provider-splight/splight/geo"
+)
+
+// ListedAsset is an asset as listed, along with its geometry
+type ListedAsset struct {
+	Id       string           `json:"id"`
+	Name     string           `json:"name"`
+	Geometry *json.RawMessage `json:"geometry"`
+	Kind     *QueryFilter     `json:"kind"`
+	Tags     []QueryFilter    `json:"tags"`
+}
+
+// AssetsInArea are the assets whose geometry intersects an area, optionally
+// of some kinds or with some tags. The platform can't query assets by
+// location, so every asset is listed and they're filtered here.
+type AssetsInArea struct {
+	Assets []ListedAsset `json:"results"`
+
+	Area  *geo.Geometry `json:"-"`
+	Kinds []string      `json:"-"`
+	Tags  []string      `json:"-"`
+}
+
+func (m *AssetsInArea) ResourcePath() string {
+	return "v3/engine/asset/assets/"
+}
+
+// bboxPolygon returns the polygon of a [west, south, east, north] bbox
+func bboxPolygon(bbox []any) (string, error) {
+	west, south, east, north := bbox[0].(float64), bbox[1].(float64), bbox[2].(float64), bbox[3].(float64)
+	if west > east || south > north {
+		return "", fmt.Errorf("bbox must be [west, south, east, north], got [%g, %g, %g, %g]", west, south, east, north)
+	}
+
+	polygon, err := json.Marshal(map[string]any{
+		"type": "Polygon",
+		"coordinates": [][][2]float64{{
+			{west, south}, {east, south}, {east, north}, {west, north}, {west, south},
+		}},
+	})
+	return string(polygon), err
+}
+
+func (m *AssetsInArea) FromSchema(d *schema.ResourceData) error {
+	var area string
+	if bbox := d.Get("bbox").([]any); len(bbox) > 0 {
+		polygon, err := bboxPolygon(bbox)
+		if err != nil {
+			return err
+		}
+		area = polygon
+	} else {
+		normalized, err := NormalizeGeometryCRS(d.Get("area").(string), d.Get("area_crs").(string))
+		if err != nil {
+			return fmt.Errorf("area must be a valid GeoJSON: %w", err)
+		}
+		if err := ValidateGeometryTypes(normalized, "Polygon", "MultiPolygon"); err != nil {
+			return fmt.Errorf("area %w", err)
+		}
+		area = normalized
+	}
+
+	geometry, err := geo.ParseGeometry(area)
+	if err != nil {
+		return err
+	}
+	m.Area = geometry
+
+	m.Kinds = nil
+	for _, kind := range d.Get("kinds").(*schema.Set).List() {
+		m.Kinds = append(m.Kinds, kind.(string))
+	}
+	m.Tags = nil
+	for _, tag := range d.Get("tags").(*schema.Set).List() {
+		m.Tags = append(m.Tags, tag.(string))
+	}
+
+	return nil
+}
+
+// matches reports whether an asset is of one of the kinds and has one of the
+// tags, when they're given
+func (m *AssetsInArea) matches(asset ListedAsset) bool {
+	if len(m.Kinds) > 0 && (asset.Kind == nil || !slices.Contains(m.Kinds, asset.Kind.Name)) {
+		return false
+	}
+	if len(m.Tags) > 0 && !slices.ContainsFunc(asset.Tags, func(tag QueryFilter) bool {
+		return slices.Contains(m.Tags, tag.Name)
+	}) {
+		return false
+	}
+	return true
+}
+
+// Filter keeps the listed assets that match the filters and intersect the
+// area. Assets without a geometry are left out, and so are the ones whose
+// geometry can't be read, with a warning.
+func (m *AssetsInArea) Filter() diag.Diagnostics {
+	var diags diag.Diagnostics
+	var assets []ListedAsset
+	for _, asset := range m.Assets {
+		if asset.Geometry == nil || !m.matches(asset) {
+			continue
+		}
+
+		intersects, err := m.intersects(asset)
+		if err != nil {
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Warning,
+				Summary:  "Skipped asset with an invalid geometry",
+				Detail:   fmt.Sprintf("Asset %s (%s) was left out of the area: %s", asset.Name, asset.Id, err),
+			})
+			continue
+		}
+		if intersects {
+			assets = append(assets, asset)
+		}
+	}
+	m.Assets = assets
+
+	return diags
+}
+
+// intersects reports whether the geometry of an asset intersects the area
+func (m *AssetsInArea) intersects(asset ListedAsset) (bool, error) {
+	geometry, err := geo.ParseGeometry(string(*asset.Geometry))
+	if err != nil {
+		return false, err
+	}
+	return geometry.Intersects(m.Area)
+}
+
+func (m *AssetsInArea) ToSchema(d *schema.ResourceData) error {
+	d.SetId("assets_in_area")
+
+	assets := make([]map[string]any, len(m.Assets))
+	for i, asset := range m.Assets {
+		var kind string
+		if asset.Kind != nil {
+			kind = asset.Kind.Name
+		}
+		assets[i] = map[string]any{
+			"id":       asset.Id,
+			"name":     asset.Name,
+			"kind":     kind,
+			"geometry": string(*asset.Geometry),
+		}
+	}
+	d.Set("assets", assets)
+
+	return nil
+}
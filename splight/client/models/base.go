@@ -32,6 +32,14 @@ type DataSource interface {
 	SchemaWritable
 }
 
+// LocalDataSource is a data source computed by the provider itself,
+// without calling the API
+type LocalDataSource interface {
+	SplightObject
+	SchemaReadable
+	SchemaWritable
+}
+
 type SplightModel interface {
 	SplightObject
 	Identifiable
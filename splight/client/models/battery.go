@@ -0,0 +1,237 @@
+package models
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+type BatteryParams struct {
+	AssetParams
+	StateOfCharge       *AssetAttribute    `json:"state_of_charge"`
+	ActivePower         *AssetAttribute    `json:"active_power"`
+	ReactivePower       *AssetAttribute    `json:"reactive_power"`
+	ChargeEnergy        *AssetAttribute    `json:"charge_energy"`
+	DischargeEnergy     *AssetAttribute    `json:"discharge_energy"`
+	SwitchStatus        *AssetAttribute    `json:"switch_status"`
+	RatedEnergy         AssetMetadata      `json:"rated_energy"`
+	RatedPower          AssetMetadata      `json:"rated_power"`
+	RoundTripEfficiency AssetMetadata      `json:"round_trip_efficiency"`
+	MinSoc              AssetMetadata      `json:"min_soc"`
+	MaxSoc              AssetMetadata      `json:"max_soc"`
+	Bus                 *AssetRelationship `json:"bus,omitempty"`
+	Grid                *AssetRelationship `json:"grid,omitempty"`
+}
+
+type Battery struct {
+	BatteryParams
+	AssetExtras
+	Id string `json:"id"`
+}
+
+func (m *Battery) GetId() string {
+	return m.Id
+}
+
+func (m *Battery) GetParams() Params {
+	return &m.BatteryParams
+}
+
+func (m *Battery) ResourcePath() string {
+	return "v3/engine/asset/batteries/"
+}
+
+func (m *Battery) FromSchema(d *schema.ResourceData) error {
+	m.Id = d.Id()
+
+	kind := convertSingleQueryFilter(d.Get("kind").(*schema.Set).List())
+	tags := convertQueryFilters(d.Get("tags").(*schema.Set).List())
+
+	// Get values of custom_timezone and geometry
+	custom_timezone := d.Get("custom_timezone").(string)
+	geometryStr := d.Get("geometry").(string)
+	busId := d.Get("bus").(string)
+	gridId := d.Get("grid").(string)
+
+	var busRel *AssetRelationship = nil
+	if busId != "" {
+		busRel = &AssetRelationship{
+			RelatedAssetId: ResourceId{
+				Id: busId,
+			},
+		}
+	}
+
+	var gridRel *AssetRelationship = nil
+	if gridId != "" {
+		gridRel = &AssetRelationship{
+			RelatedAssetId: ResourceId{
+				Id: gridId,
+			},
+		}
+	}
+
+	// Reproject to WGS84, validate and normalize the geometry if it's set
+	if geometryStr != "" {
+		normalized, err := NormalizeGeometryCRS(geometryStr, d.Get("geometry_crs").(string))
+		if err != nil {
+			return fmt.Errorf("geometry must be a valid GeoJSON: %w", err)
+		}
+		geometryStr = normalized
+	}
+
+	// Check if geometryStr is empty and handle accordingly
+	var geometry *json.RawMessage
+	if geometryStr != "" {
+		// Convert string to json.RawMessage
+		raw := json.RawMessage(geometryStr)
+		geometry = &raw
+	}
+
+	m.BatteryParams = BatteryParams{
+		AssetParams: AssetParams{
+			Name:              d.Get("name").(string),
+			Description:       d.Get("description").(string),
+			Geometry:          geometry,
+			CustomTimezone:    custom_timezone,
+			UseCustomTimezone: custom_timezone != "",
+			Tags:              tags,
+			Kind:              kind,
+			Parent:            convertParent(d),
+		},
+		Bus:  busRel,
+		Grid: gridRel,
+	}
+
+	ratedEnergy, err := convertAssetMetadata(d, "rated_energy")
+	if err != nil {
+		return fmt.Errorf("invalid rated energy metadata: %w", err)
+	}
+	if ratedEnergy.Type == "" {
+		ratedEnergy.Type = "Number"
+	}
+	if ratedEnergy.Name == "" {
+		ratedEnergy.Name = "rated_energy"
+	}
+	m.BatteryParams.RatedEnergy = *ratedEnergy
+
+	ratedPower, err := convertAssetMetadata(d, "rated_power")
+	if err != nil {
+		return fmt.Errorf("invalid rated power metadata: %w", err)
+	}
+	if ratedPower.Type == "" {
+		ratedPower.Type = "Number"
+	}
+	if ratedPower.Name == "" {
+		ratedPower.Name = "rated_power"
+	}
+	m.BatteryParams.RatedPower = *ratedPower
+
+	roundTripEfficiency, err := convertAssetMetadata(d, "round_trip_efficiency")
+	if err != nil {
+		return fmt.Errorf("invalid round trip efficiency metadata: %w", err)
+	}
+	if roundTripEfficiency.Type == "" {
+		roundTripEfficiency.Type = "Number"
+	}
+	if roundTripEfficiency.Name == "" {
+		roundTripEfficiency.Name = "round_trip_efficiency"
+	}
+	m.BatteryParams.RoundTripEfficiency = *roundTripEfficiency
+
+	minSoc, err := convertAssetMetadata(d, "min_soc")
+	if err != nil {
+		return fmt.Errorf("invalid min soc metadata: %w", err)
+	}
+	if minSoc.Type == "" {
+		minSoc.Type = "Number"
+	}
+	if minSoc.Name == "" {
+		minSoc.Name = "min_soc"
+	}
+	m.BatteryParams.MinSoc = *minSoc
+
+	maxSoc, err := convertAssetMetadata(d, "max_soc")
+	if err != nil {
+		return fmt.Errorf("invalid max soc metadata: %w", err)
+	}
+	if maxSoc.Type == "" {
+		maxSoc.Type = "Number"
+	}
+	if maxSoc.Name == "" {
+		maxSoc.Name = "max_soc"
+	}
+	m.BatteryParams.MaxSoc = *maxSoc
+
+	return m.extrasFromSchema(d)
+}
+
+func (m *Battery) ToSchema(d *schema.ResourceData) error {
+	d.SetId(m.Id)
+
+	d.Set("name", m.AssetParams.Name)
+	d.Set("description", m.AssetParams.Description)
+
+	if m.Bus != nil {
+		d.Set("bus", m.Bus.RelatedAssetId.Id)
+	} else {
+		d.Set("bus", "")
+	}
+
+	if m.Grid != nil {
+		d.Set("grid", m.Grid.RelatedAssetId.Id)
+	} else {
+		d.Set("grid", "")
+	}
+
+	var geometryStr string
+	if m.Geometry != nil {
+		geometryStr = string(*m.Geometry)
+	} else {
+		geometryStr = ""
+	}
+	d.Set("geometry", geometryStr)
+
+	d.Set("timezone", m.Timezone)
+	d.Set("custom_timezone", m.CustomTimezone)
+
+	var tags []map[string]any
+	for _, tag := range m.AssetParams.Tags {
+		tags = append(tags, map[string]any{
+			"id":   tag.Id,
+			"name": tag.Name,
+		})
+	}
+	d.Set("tags", tags)
+
+	d.Set("kind", []map[string]any{
+		{
+			"id":   m.AssetParams.Kind.Id,
+			"name": m.AssetParams.Kind.Name,
+		},
+	})
+
+	if m.Parent != nil {
+		d.Set("parent", m.Parent.Id)
+	} else {
+		d.Set("parent", "")
+	}
+
+	d.Set("state_of_charge", []map[string]any{m.StateOfCharge.ToMap()})
+	d.Set("active_power", []map[string]any{m.ActivePower.ToMap()})
+	d.Set("reactive_power", []map[string]any{m.ReactivePower.ToMap()})
+	d.Set("charge_energy", []map[string]any{m.ChargeEnergy.ToMap()})
+	d.Set("discharge_energy", []map[string]any{m.DischargeEnergy.ToMap()})
+	d.Set("switch_status", []map[string]any{m.SwitchStatus.ToMap()})
+	d.Set("rated_energy", []map[string]any{m.RatedEnergy.ToMap()})
+	d.Set("rated_power", []map[string]any{m.RatedPower.ToMap()})
+	d.Set("round_trip_efficiency", []map[string]any{m.RoundTripEfficiency.ToMap()})
+	d.Set("min_soc", []map[string]any{m.MinSoc.ToMap()})
+	d.Set("max_soc", []map[string]any{m.MaxSoc.ToMap()})
+
+	m.extrasToSchema(d)
+	setAssetReferences(d, &m.BatteryParams, &m.AssetExtras)
+
+	return nil
+}
@@ -16,6 +16,7 @@ type BusParams struct {
 
 type Bus struct {
 	BusParams
+	AssetExtras
 	Id string `json:"id"`
 }
 
@@ -41,11 +42,13 @@ func (m *Bus) FromSchema(d *schema.ResourceData) error {
 	custom_timezone := d.Get("custom_timezone").(string)
 	geometryStr := d.Get("geometry").(string)
 
-	// Validate geometry JSON if it's set
+	// Reproject to WGS84, validate and normalize the geometry if it's set
 	if geometryStr != "" {
-		if err := validateJSONString(geometryStr); err != nil {
-			return fmt.Errorf("geometry must be a JSON encoded GeoJSON")
+		normalized, err := NormalizeGeometryCRS(geometryStr, d.Get("geometry_crs").(string))
+		if err != nil {
+			return fmt.Errorf("geometry must be a valid GeoJSON: %w", err)
 		}
+		geometryStr = normalized
 	}
 
 	// Check if geometryStr is empty and handle accordingly
@@ -65,10 +68,11 @@ func (m *Bus) FromSchema(d *schema.ResourceData) error {
 			UseCustomTimezone: custom_timezone != "",
 			Tags:              tags,
 			Kind:              kind,
+			Parent:            convertParent(d),
 		},
 	}
 
-	nominalVoltageKV, err := convertAssetMetadata(d.Get("nominal_voltage_kv").(*schema.Set).List())
+	nominalVoltageKV, err := convertAssetMetadata(d, "nominal_voltage_kv")
 	if err != nil {
 		return fmt.Errorf("invalid nominal voltage metadata: %w", err)
 	}
@@ -80,7 +84,7 @@ func (m *Bus) FromSchema(d *schema.ResourceData) error {
 	}
 	m.BusParams.NominalVoltageKV = *nominalVoltageKV
 
-	return nil
+	return m.extrasFromSchema(d)
 }
 
 func (m *Bus) ToSchema(d *schema.ResourceData) error {
@@ -116,7 +120,16 @@ func (m *Bus) ToSchema(d *schema.ResourceData) error {
 		},
 	})
 
+	if m.Parent != nil {
+		d.Set("parent", m.Parent.Id)
+	} else {
+		d.Set("parent", "")
+	}
+
 	d.Set("nominal_voltage_kv", []map[string]any{m.NominalVoltageKV.ToMap()})
 
+	m.extrasToSchema(d)
+	setAssetReferences(d, &m.BusParams, &m.AssetExtras)
+
 	return nil
 }
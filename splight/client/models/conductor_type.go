@@ -0,0 +1,36 @@
+package models
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/splightplatform/terraform-provider-splight/splight/electrical"
+)
+
+// ConductorType describes a standard conductor from the embedded catalog
+type ConductorType struct {
+	Name string
+	electrical.ConductorType
+}
+
+func (m *ConductorType) FromSchema(d *schema.ResourceData) error {
+	m.Name = d.Get("name").(string)
+
+	conductorType, err := electrical.LookupConductorType(m.Name)
+	if err != nil {
+		return err
+	}
+	m.ConductorType = conductorType
+
+	return nil
+}
+
+func (m *ConductorType) ToSchema(d *schema.ResourceData) error {
+	d.SetId(m.Name)
+
+	d.Set("stranding", m.Stranding)
+	for name, value := range m.Metadata() {
+		d.Set(name, value)
+	}
+	d.Set("units", electrical.ConductorUnits)
+
+	return nil
+}
@@ -0,0 +1,115 @@
+package models
+
+import (
+	"encoding/json"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/splightplatform/terraform-provider-splight/splight/electrical"
+)
+
+// ConfigValidator is implemented by models that need to inspect the whole
+// resource configuration at plan time, e.g. to emit warnings about values
+// that are valid on their own but inconsistent with each other.
+type ConfigValidator interface {
+	ValidateConfig(config cty.Value) diag.Diagnostics
+}
+
+// configAttribute returns the value of a top level attribute, if it is set and known
+func configAttribute(config cty.Value, key string) (cty.Value, bool) {
+	if config.IsNull() || !config.IsKnown() || !config.Type().IsObjectType() || !config.Type().HasAttribute(key) {
+		return cty.NilVal, false
+	}
+
+	value := config.GetAttr(key)
+	if value.IsNull() || !value.IsKnown() {
+		return cty.NilVal, false
+	}
+
+	return value, true
+}
+
+// ConfigString returns a known, non null string attribute of a configuration
+func ConfigString(config cty.Value, key string) (string, bool) {
+	value, ok := configAttribute(config, key)
+	if !ok || value.Type() != cty.String {
+		return "", false
+	}
+	return value.AsString(), true
+}
+
+// configBool returns a known, non null bool attribute
+func configBool(config cty.Value, key string) (bool, bool) {
+	value, ok := configAttribute(config, key)
+	if !ok || value.Type() != cty.Bool {
+		return false, false
+	}
+	return value.True(), true
+}
+
+// configBlockItem returns the item of a single item block (MaxItems: 1)
+func configBlockItem(config cty.Value, block string) (cty.Value, bool) {
+	value, ok := configAttribute(config, block)
+	if !ok || !value.CanIterateElements() || value.LengthInt() != 1 {
+		return cty.NilVal, false
+	}
+
+	for it := value.ElementIterator(); it.Next(); {
+		_, item := it.Element()
+		return item, true
+	}
+
+	return cty.NilVal, false
+}
+
+// configBlockValue returns the JSON encoded value of a single metadata block,
+// whether it is set through value, one of the typed values or a quantity
+func configBlockValue(config cty.Value, block string) (json.RawMessage, bool) {
+	item, ok := configBlockItem(config, block)
+	if !ok {
+		return nil, false
+	}
+
+	if value, ok := ConfigString(item, "value"); ok {
+		return json.RawMessage(value), true
+	}
+	if value, ok := configAttribute(item, "number_value"); ok && value.Type() == cty.Number {
+		number, _ := value.AsBigFloat().Float64()
+		return encodeNumber(number), true
+	}
+	if value, ok := ConfigString(item, "string_value"); ok {
+		encoded, _ := json.Marshal(value)
+		return encoded, true
+	}
+	if value, ok := configBool(item, "bool_value"); ok {
+		encoded, _ := json.Marshal(value)
+		return encoded, true
+	}
+	if value, ok := ConfigString(item, "quantity"); ok {
+		number, _, err := convertQuantity(value, electrical.MetadataUnits[block])
+		if err != nil {
+			return nil, false
+		}
+		return encodeNumber(number), true
+	}
+
+	return nil, false
+}
+
+// blockConfigured reports whether a block is present in the configuration.
+// Blocks that are both optional and computed keep their previous value in
+// the state when removed from the configuration, so d.Get can't tell.
+func blockConfigured(d *schema.ResourceData, block string) bool {
+	config := d.GetRawConfig()
+	if config.IsNull() {
+		// Raw configuration is not available, fall back to the merged value
+		return d.Get(block).(*schema.Set).Len() > 0
+	}
+
+	value, ok := configAttribute(config, block)
+	if !ok || !value.CanIterateElements() {
+		return false
+	}
+	return value.LengthInt() > 0
+}
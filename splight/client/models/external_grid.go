@@ -23,6 +23,7 @@ type AssetRelationship struct {
 
 type ExternalGrid struct {
 	ExternalGridParams
+	AssetExtras
 	Id string `json:"id"`
 }
 
@@ -68,11 +69,13 @@ func (m *ExternalGrid) FromSchema(d *schema.ResourceData) error {
 		}
 	}
 
-	// Validate geometry JSON if it's set
+	// Reproject to WGS84, validate and normalize the geometry if it's set
 	if geometryStr != "" {
-		if err := validateJSONString(geometryStr); err != nil {
-			return fmt.Errorf("geometry must be a JSON encoded GeoJSON")
+		normalized, err := NormalizeGeometryCRS(geometryStr, d.Get("geometry_crs").(string))
+		if err != nil {
+			return fmt.Errorf("geometry must be a valid GeoJSON: %w", err)
 		}
+		geometryStr = normalized
 	}
 
 	// Check if geometryStr is empty and handle accordingly
@@ -92,12 +95,13 @@ func (m *ExternalGrid) FromSchema(d *schema.ResourceData) error {
 			UseCustomTimezone: custom_timezone != "",
 			Tags:              tags,
 			Kind:              kind,
+			Parent:            convertParent(d),
 		},
 		Bus:  busRel,
 		Grid: gridRel,
 	}
 
-	return nil
+	return m.extrasFromSchema(d)
 }
 
 func (m *ExternalGrid) ToSchema(d *schema.ResourceData) error {
@@ -145,5 +149,14 @@ func (m *ExternalGrid) ToSchema(d *schema.ResourceData) error {
 		},
 	})
 
+	if m.Parent != nil {
+		d.Set("parent", m.Parent.Id)
+	} else {
+		d.Set("parent", "")
+	}
+
+	m.extrasToSchema(d)
+	setAssetReferences(d, &m.ExternalGridParams, &m.AssetExtras)
+
 	return nil
 }
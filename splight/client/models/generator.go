@@ -18,6 +18,7 @@ type GeneratorParams struct {
 
 type Generator struct {
 	GeneratorParams
+	AssetExtras
 	Id string `json:"id"`
 }
 
@@ -43,11 +44,13 @@ func (m *Generator) FromSchema(d *schema.ResourceData) error {
 	custom_timezone := d.Get("custom_timezone").(string)
 	geometryStr := d.Get("geometry").(string)
 
-	// Validate geometry JSON if it's set
+	// Reproject to WGS84, validate and normalize the geometry if it's set
 	if geometryStr != "" {
-		if err := validateJSONString(geometryStr); err != nil {
-			return fmt.Errorf("geometry must be a JSON encoded GeoJSON")
+		normalized, err := NormalizeGeometryCRS(geometryStr, d.Get("geometry_crs").(string))
+		if err != nil {
+			return fmt.Errorf("geometry must be a valid GeoJSON: %w", err)
 		}
+		geometryStr = normalized
 	}
 
 	// Check if geometryStr is empty and handle accordingly
@@ -67,10 +70,11 @@ func (m *Generator) FromSchema(d *schema.ResourceData) error {
 			UseCustomTimezone: custom_timezone != "",
 			Tags:              tags,
 			Kind:              kind,
+			Parent:            convertParent(d),
 		},
 	}
 
-	return nil
+	return m.extrasFromSchema(d)
 }
 
 func (m *Generator) ToSchema(d *schema.ResourceData) error {
@@ -106,11 +110,20 @@ func (m *Generator) ToSchema(d *schema.ResourceData) error {
 		},
 	})
 
+	if m.Parent != nil {
+		d.Set("parent", m.Parent.Id)
+	} else {
+		d.Set("parent", "")
+	}
+
 	d.Set("active_power", []map[string]any{m.ActivePower.ToMap()})
 	d.Set("reactive_power", []map[string]any{m.ReactivePower.ToMap()})
 	d.Set("daily_energy", []map[string]any{m.DailyEnergy.ToMap()})
 	d.Set("daily_emission_avoided", []map[string]any{m.DailyEmissionAvoided.ToMap()})
 	d.Set("monthly_energy", []map[string]any{m.MonthlyEnergy.ToMap()})
 
+	m.extrasToSchema(d)
+	setAssetReferences(d, &m.GeneratorParams, &m.AssetExtras)
+
 	return nil
 }
@@ -0,0 +1,319 @@
+package models
+
+import (
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/splightplatform/terraform-provider-splight/splight/geo"
+)
+
+// Coordinates are rounded to this many decimal places (about 1 cm), and
+// altitudes to millimeters
+const (
+	geometryPrecision = 7
+	altitudePrecision = 3
+)
+
+// geoJSONObject holds the members of any GeoJSON object, geometries and features
+type geoJSONObject struct {
+	Type        string            `json:"type"`
+	Coordinates json.RawMessage   `json:"coordinates"`
+	Geometries  []json.RawMessage `json:"geometries"`
+	Geometry    json.RawMessage   `json:"geometry"`
+	Features    []json.RawMessage `json:"features"`
+}
+
+// NormalizeGeometry validates a GeoJSON geometry against the RFC 7946 shape
+// rules and returns it in the form the platform expects: a GeometryCollection
+// with no nested collections, coordinates rounded to a fixed precision and
+// polygon rings following the right-hand rule. Features and FeatureCollections
+// are accepted and replaced by their geometries.
+func NormalizeGeometry(s string) (string, error) {
+	members, err := normalizeGeoJSON(json.RawMessage(s), "")
+	if err != nil {
+		return "", err
+	}
+	return geo.NewGeometryCollection(members...).String(), nil
+}
+
+// ValidateGeometry checks a GeoJSON geometry against the RFC 7946 shape rules
+func ValidateGeometry(s string) error {
+	_, err := NormalizeGeometry(s)
+	return err
+}
+
+// ReprojectGeometry converts the positions of a GeoJSON geometry from a
+// coordinate reference system, e.g. "EPSG:32719", to WGS84 longitude and
+// latitude. An empty crs means the geometry is already in WGS84.
+func ReprojectGeometry(s, crs string) (string, error) {
+	if crs == "" {
+		return s, nil
+	}
+
+	system, err := geo.LookupCRS(crs)
+	if err != nil {
+		return "", err
+	}
+
+	return geo.Reproject(s, system)
+}
+
+// NormalizeGeometryCRS reprojects a geometry from crs to WGS84 and normalizes it
+func NormalizeGeometryCRS(s, crs string) (string, error) {
+	reprojected, err := ReprojectGeometry(s, crs)
+	if err != nil {
+		return "", err
+	}
+	return NormalizeGeometry(reprojected)
+}
+
+// ValidateGeometryTypes checks that every member of a normalized geometry is
+// of one of the given types, e.g. only polygons for an area
+func ValidateGeometryTypes(s string, types ...string) error {
+	var collection geo.Geometry
+	if err := json.Unmarshal([]byte(s), &collection); err != nil {
+		return fmt.Errorf("invalid GeoJSON: %w", err)
+	}
+
+	for _, member := range collection.Geometries {
+		if !slices.Contains(types, member.Type) {
+			return fmt.Errorf("geometry must only contain %s, got %s", strings.Join(types, " or "), member.Type)
+		}
+	}
+	return nil
+}
+
+// GeometryEqual reports whether two GeoJSON geometries are the same once
+// normalized. Geometries that can't be normalized are never equal.
+func GeometryEqual(a, b string) bool {
+	if a == b {
+		return true
+	}
+
+	normalizedA, err := NormalizeGeometry(a)
+	if err != nil {
+		return false
+	}
+	normalizedB, err := NormalizeGeometry(b)
+	if err != nil {
+		return false
+	}
+
+	return normalizedA == normalizedB
+}
+
+// normalizeGeoJSON returns the normalized geometries found in a GeoJSON object
+func normalizeGeoJSON(raw json.RawMessage, path string) ([]*geo.Geometry, error) {
+	var object geoJSONObject
+	if err := json.Unmarshal(raw, &object); err != nil {
+		return nil, geometryError(path, "invalid GeoJSON: %s", err)
+	}
+
+	switch object.Type {
+	case "":
+		return nil, geometryError(path, "missing type")
+	case "Feature":
+		if len(object.Geometry) == 0 || string(object.Geometry) == "null" {
+			return nil, nil
+		}
+		return normalizeGeoJSON(object.Geometry, path+".geometry")
+	case "FeatureCollection":
+		var members []*geo.Geometry
+		for i, feature := range object.Features {
+			geometries, err := normalizeGeoJSON(feature, fmt.Sprintf("%s.features[%d]", path, i))
+			if err != nil {
+				return nil, err
+			}
+			members = append(members, geometries...)
+		}
+		return members, nil
+	case "GeometryCollection":
+		// Nested collections are flattened, as RFC 7946 recommends
+		var members []*geo.Geometry
+		for i, member := range object.Geometries {
+			geometries, err := normalizeGeoJSON(member, fmt.Sprintf("%s.geometries[%d]", path, i))
+			if err != nil {
+				return nil, err
+			}
+			members = append(members, geometries...)
+		}
+		return members, nil
+	}
+
+	coordinates, err := normalizeCoordinates(object.Type, object.Coordinates, path)
+	if err != nil {
+		return nil, err
+	}
+
+	encoded, err := json.Marshal(coordinates)
+	if err != nil {
+		return nil, geometryError(path, "%s", err)
+	}
+
+	return []*geo.Geometry{{Type: object.Type, Coordinates: encoded}}, nil
+}
+
+// normalizeCoordinates decodes, validates and normalizes the coordinates of
+// a single geometry according to their type
+func normalizeCoordinates(geometryType string, raw json.RawMessage, path string) (any, error) {
+	if len(raw) == 0 || string(raw) == "null" {
+		return nil, geometryError(path, "%s is missing coordinates", geometryType)
+	}
+
+	decode := func(target any) error {
+		if err := json.Unmarshal(raw, target); err != nil {
+			return geometryError(path, "invalid %s coordinates: %s", geometryType, err)
+		}
+		return nil
+	}
+
+	switch geometryType {
+	case "Point":
+		var position geo.Position
+		if err := decode(&position); err != nil {
+			return nil, err
+		}
+		return normalizePosition(position, path+".coordinates")
+	case "MultiPoint":
+		var positions []geo.Position
+		if err := decode(&positions); err != nil {
+			return nil, err
+		}
+		return normalizePositions(positions, path+".coordinates")
+	case "LineString":
+		var line []geo.Position
+		if err := decode(&line); err != nil {
+			return nil, err
+		}
+		return normalizeLineString(line, path+".coordinates")
+	case "MultiLineString":
+		var lines [][]geo.Position
+		if err := decode(&lines); err != nil {
+			return nil, err
+		}
+		for i, line := range lines {
+			normalized, err := normalizeLineString(line, fmt.Sprintf("%s.coordinates[%d]", path, i))
+			if err != nil {
+				return nil, err
+			}
+			lines[i] = normalized
+		}
+		return lines, nil
+	case "Polygon":
+		var rings [][]geo.Position
+		if err := decode(&rings); err != nil {
+			return nil, err
+		}
+		return normalizePolygon(rings, path+".coordinates")
+	case "MultiPolygon":
+		var polygons [][][]geo.Position
+		if err := decode(&polygons); err != nil {
+			return nil, err
+		}
+		for i, rings := range polygons {
+			normalized, err := normalizePolygon(rings, fmt.Sprintf("%s.coordinates[%d]", path, i))
+			if err != nil {
+				return nil, err
+			}
+			polygons[i] = normalized
+		}
+		return polygons, nil
+	}
+
+	return nil, geometryError(path, "unknown geometry type %q", geometryType)
+}
+
+func normalizePosition(position geo.Position, path string) (geo.Position, error) {
+	lonInRange := position.Lon >= -180 && position.Lon <= 180
+	latInRange := position.Lat >= -90 && position.Lat <= 90
+
+	if !latInRange && position.Lon >= -90 && position.Lon <= 90 && position.Lat >= -180 && position.Lat <= 180 {
+		return position, geometryError(
+			path, "latitude %g is out of range, GeoJSON positions are [longitude, latitude] and these look swapped",
+			position.Lat,
+		)
+	}
+	if !lonInRange {
+		return position, geometryError(path, "longitude %g is out of range [-180, 180]", position.Lon)
+	}
+	if !latInRange {
+		return position, geometryError(path, "latitude %g is out of range [-90, 90]", position.Lat)
+	}
+
+	position.Lon = geo.Round(position.Lon, geometryPrecision)
+	position.Lat = geo.Round(position.Lat, geometryPrecision)
+	if position.HasAlt {
+		position.Alt = geo.Round(position.Alt, altitudePrecision)
+	}
+
+	return position, nil
+}
+
+func normalizePositions(positions []geo.Position, path string) ([]geo.Position, error) {
+	for i, position := range positions {
+		normalized, err := normalizePosition(position, fmt.Sprintf("%s[%d]", path, i))
+		if err != nil {
+			return nil, err
+		}
+		positions[i] = normalized
+	}
+	return positions, nil
+}
+
+func normalizeLineString(line []geo.Position, path string) ([]geo.Position, error) {
+	if len(line) < 2 {
+		return nil, geometryError(path, "LineString must have at least two positions, got %d", len(line))
+	}
+	return normalizePositions(line, path)
+}
+
+// normalizePolygon checks that every ring is closed and orients the exterior
+// ring counterclockwise and the holes clockwise (RFC 7946, 3.1.6)
+func normalizePolygon(rings [][]geo.Position, path string) ([][]geo.Position, error) {
+	for i, ring := range rings {
+		ringPath := fmt.Sprintf("%s[%d]", path, i)
+
+		if len(ring) < 4 {
+			return nil, geometryError(ringPath, "polygon ring must have at least four positions, got %d", len(ring))
+		}
+		first, last := ring[0], ring[len(ring)-1]
+		if first.Lon != last.Lon || first.Lat != last.Lat || first.Alt != last.Alt {
+			return nil, geometryError(ringPath, "polygon ring is not closed, its first and last positions must be equal")
+		}
+
+		normalized, err := normalizePositions(ring, ringPath)
+		if err != nil {
+			return nil, err
+		}
+
+		exterior := i == 0
+		if counterclockwise := signedArea(normalized) > 0; counterclockwise != exterior {
+			for left, right := 0, len(normalized)-1; left < right; left, right = left+1, right-1 {
+				normalized[left], normalized[right] = normalized[right], normalized[left]
+			}
+		}
+		rings[i] = normalized
+	}
+
+	return rings, nil
+}
+
+// signedArea returns the planar shoelace area of a closed ring in degrees²,
+// positive when the ring is counterclockwise
+func signedArea(ring []geo.Position) float64 {
+	area := 0.0
+	for i := 1; i < len(ring); i++ {
+		area += ring[i-1].Lon*ring[i].Lat - ring[i].Lon*ring[i-1].Lat
+	}
+	return area / 2
+}
+
+func geometryError(path, format string, args ...any) error {
+	message := fmt.Sprintf(format, args...)
+	if path == "" {
+		return fmt.Errorf("%s", message)
+	}
+	return fmt.Errorf("%s: %s", path[1:], message)
+}
@@ -0,0 +1,250 @@
+package models
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/splightplatform/terraform-provider-splight/splight/geo"
+)
+
+// GeometryFileFormats are the file formats splight_geometry_file can read
+var GeometryFileFormats = []string{"geojson", "kml", "shapefile"}
+
+// GeometryFileFeature is a feature of a geometry file, with its geometry in
+// the canonical form the platform stores
+type GeometryFileFeature struct {
+	Key        string
+	Name       string
+	Geometry   string
+	Properties map[string]any
+}
+
+// GeometryFile holds the features read from a GeoJSON, KML or shapefile
+type GeometryFile struct {
+	Path        string
+	Format      string
+	CRS         string
+	KeyProperty string
+	Features    []GeometryFileFeature
+}
+
+// geometryFileFormat infers the format of a file from its extension
+func geometryFileFormat(path string) (string, error) {
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".geojson", ".json":
+		return "geojson", nil
+	case ".kml":
+		return "kml", nil
+	case ".zip", ".shp":
+		return "shapefile", nil
+	}
+	return "", fmt.Errorf("can't infer the format of %s from its extension, set format to one of %s", path, strings.Join(GeometryFileFormats, ", "))
+}
+
+// readGeometryFile returns the features of a file and the reference system
+// it declares, if any
+func readGeometryFile(path, format string) ([]geo.Feature, *geo.CRS, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	switch format {
+	case "geojson":
+		features, err := geo.ParseGeoJSONFeatures(string(data))
+		if err != nil {
+			return nil, nil, err
+		}
+		if declared := geo.GeoJSONCRS(string(data)); declared != "" {
+			crs, err := geo.LookupCRS(declared)
+			if err != nil {
+				return nil, nil, fmt.Errorf("GeoJSON crs member: %w", err)
+			}
+			return features, crs, nil
+		}
+		return features, nil, nil
+	case "kml":
+		// KML is always WGS84
+		features, err := geo.ParseKMLFeatures(string(data))
+		return features, nil, err
+	}
+
+	var shapefile *geo.Shapefile
+	if strings.EqualFold(filepath.Ext(path), ".shp") {
+		shapefile, err = readShapefileSiblings(path, data)
+	} else {
+		shapefile, err = geo.ReadShapefileZip(data)
+	}
+	if err != nil {
+		return nil, nil, err
+	}
+
+	features, err := shapefile.Features()
+	if err != nil {
+		return nil, nil, err
+	}
+	if shapefile.Prj == "" {
+		return features, nil, nil
+	}
+
+	crs, err := geo.CRSFromPrj(shapefile.Prj)
+	if err != nil {
+		return nil, nil, fmt.Errorf("%w, set crs to the reference system of the shapefile", err)
+	}
+	return features, crs, nil
+}
+
+// readShapefileSiblings reads the .dbf and .prj files next to a .shp
+func readShapefileSiblings(path string, shp []byte) (*geo.Shapefile, error) {
+	base := strings.TrimSuffix(path, filepath.Ext(path))
+	shapefile := &geo.Shapefile{Shp: shp}
+
+	dbf, err := os.ReadFile(base + ".dbf")
+	if err != nil && !os.IsNotExist(err) {
+		return nil, err
+	}
+	shapefile.Dbf = dbf
+
+	prj, err := os.ReadFile(base + ".prj")
+	if err != nil && !os.IsNotExist(err) {
+		return nil, err
+	}
+	shapefile.Prj = string(prj)
+
+	return shapefile, nil
+}
+
+// featureKey returns the key of a feature: the value of keyProperty if set,
+// otherwise its id, its name or its position in the file
+func featureKey(feature geo.Feature, index int, keyProperty string) (string, error) {
+	if keyProperty != "" {
+		value, ok := feature.Properties[keyProperty]
+		if !ok || value == nil {
+			return "", fmt.Errorf("feature %d has no %s property", index, keyProperty)
+		}
+		switch value := value.(type) {
+		case string:
+			return value, nil
+		case float64:
+			return strconv.FormatFloat(value, 'f', -1, 64), nil
+		}
+		encoded, _ := json.Marshal(value)
+		return string(encoded), nil
+	}
+
+	switch {
+	case feature.ID != "":
+		return feature.ID, nil
+	case feature.Name != "":
+		return feature.Name, nil
+	}
+	return strconv.Itoa(index), nil
+}
+
+func (m *GeometryFile) FromSchema(d *schema.ResourceData) error {
+	m.Path = d.Get("path").(string)
+	m.Format = d.Get("format").(string)
+	m.CRS = d.Get("crs").(string)
+	m.KeyProperty = d.Get("key_property").(string)
+
+	if m.Format == "" {
+		format, err := geometryFileFormat(m.Path)
+		if err != nil {
+			return err
+		}
+		m.Format = format
+	}
+
+	features, declared, err := readGeometryFile(m.Path, m.Format)
+	if err != nil {
+		return fmt.Errorf("error reading %s: %w", m.Path, err)
+	}
+
+	// An explicit crs overrides the one declared by the file
+	crs := declared
+	if m.CRS != "" {
+		if crs, err = geo.LookupCRS(m.CRS); err != nil {
+			return err
+		}
+	}
+
+	m.Features = make([]GeometryFileFeature, 0, len(features))
+	keys := map[string]int{}
+	for i, feature := range features {
+		key, err := featureKey(feature, i, m.KeyProperty)
+		if err != nil {
+			return err
+		}
+		if previous, ok := keys[key]; ok {
+			return fmt.Errorf("features %d and %d have the same key %q, set key_property to a property that is unique", previous, i, key)
+		}
+		keys[key] = i
+
+		geometryStr := feature.Geometry.String()
+		if crs != nil {
+			if geometryStr, err = geo.Reproject(geometryStr, crs); err != nil {
+				return fmt.Errorf("feature %q: %w", key, err)
+			}
+		}
+		normalized, err := NormalizeGeometry(geometryStr)
+		if err != nil {
+			return fmt.Errorf("feature %q: invalid geometry: %w", key, err)
+		}
+
+		m.Features = append(m.Features, GeometryFileFeature{
+			Key:        key,
+			Name:       feature.Name,
+			Geometry:   normalized,
+			Properties: feature.Properties,
+		})
+	}
+
+	return nil
+}
+
+// propertyString returns a property value as a string, JSON encoding
+// anything but strings
+func propertyString(value any) string {
+	if s, ok := value.(string); ok {
+		return s
+	}
+	encoded, _ := json.Marshal(value)
+	return string(encoded)
+}
+
+func (m *GeometryFile) ToSchema(d *schema.ResourceData) error {
+	d.SetId(m.Path)
+
+	d.Set("format", m.Format)
+
+	geometries := map[string]any{}
+	properties := map[string]any{}
+	features := make([]map[string]any, len(m.Features))
+	for i, feature := range m.Features {
+		geometries[feature.Key] = feature.Geometry
+		properties[feature.Key] = propertyString(feature.Properties)
+
+		featureProperties := map[string]any{}
+		for key, value := range feature.Properties {
+			featureProperties[key] = propertyString(value)
+		}
+
+		features[i] = map[string]any{
+			"key":        feature.Key,
+			"name":       feature.Name,
+			"geometry":   feature.Geometry,
+			"properties": featureProperties,
+		}
+	}
+
+	d.Set("geometries", geometries)
+	d.Set("properties", properties)
+	d.Set("features", features)
+
+	return nil
+}
@@ -24,6 +24,7 @@ type InverterParams struct {
 
 type Inverter struct {
 	InverterParams
+	AssetExtras
 	Id string `json:"id"`
 }
 
@@ -49,11 +50,13 @@ func (m *Inverter) FromSchema(d *schema.ResourceData) error {
 	custom_timezone := d.Get("custom_timezone").(string)
 	geometryStr := d.Get("geometry").(string)
 
-	// Validate geometry JSON if it's set
+	// Reproject to WGS84, validate and normalize the geometry if it's set
 	if geometryStr != "" {
-		if err := validateJSONString(geometryStr); err != nil {
-			return fmt.Errorf("geometry must be a JSON encoded GeoJSON")
+		normalized, err := NormalizeGeometryCRS(geometryStr, d.Get("geometry_crs").(string))
+		if err != nil {
+			return fmt.Errorf("geometry must be a valid GeoJSON: %w", err)
 		}
+		geometryStr = normalized
 	}
 
 	// Check if geometryStr is empty and handle accordingly
@@ -73,10 +76,11 @@ func (m *Inverter) FromSchema(d *schema.ResourceData) error {
 			UseCustomTimezone: custom_timezone != "",
 			Tags:              tags,
 			Kind:              kind,
+			Parent:            convertParent(d),
 		},
 	}
 
-	make, err := convertAssetMetadata(d.Get("make").(*schema.Set).List())
+	make, err := convertAssetMetadata(d, "make")
 	if err != nil {
 		return fmt.Errorf("invalid make metadata: %w", err)
 	}
@@ -88,7 +92,7 @@ func (m *Inverter) FromSchema(d *schema.ResourceData) error {
 	}
 	m.InverterParams.Make = *make
 
-	model, err := convertAssetMetadata(d.Get("model").(*schema.Set).List())
+	model, err := convertAssetMetadata(d, "model")
 	if err != nil {
 		return fmt.Errorf("invalid model metadata: %w", err)
 	}
@@ -100,7 +104,7 @@ func (m *Inverter) FromSchema(d *schema.ResourceData) error {
 	}
 	m.InverterParams.Model = *model
 
-	serialNumber, err := convertAssetMetadata(d.Get("serial_number").(*schema.Set).List())
+	serialNumber, err := convertAssetMetadata(d, "serial_number")
 	if err != nil {
 		return fmt.Errorf("invalid serial number metadata: %w", err)
 	}
@@ -112,7 +116,7 @@ func (m *Inverter) FromSchema(d *schema.ResourceData) error {
 	}
 	m.InverterParams.SerialNumber = *serialNumber
 
-	maxActivePower, err := convertAssetMetadata(d.Get("max_active_power").(*schema.Set).List())
+	maxActivePower, err := convertAssetMetadata(d, "max_active_power")
 	if err != nil {
 		return fmt.Errorf("invalid max active power metadata: %w", err)
 	}
@@ -124,7 +128,7 @@ func (m *Inverter) FromSchema(d *schema.ResourceData) error {
 	}
 	m.InverterParams.MaxActivePower = *maxActivePower
 
-	energyMeasurementType, err := convertAssetMetadata(d.Get("energy_measurement_type").(*schema.Set).List())
+	energyMeasurementType, err := convertAssetMetadata(d, "energy_measurement_type")
 	if err != nil {
 		return fmt.Errorf("invalid energy measurement type metadata: %w", err)
 	}
@@ -136,7 +140,7 @@ func (m *Inverter) FromSchema(d *schema.ResourceData) error {
 	}
 	m.InverterParams.EnergyMeasurementType = *energyMeasurementType
 
-	return nil
+	return m.extrasFromSchema(d)
 }
 
 func (m *Inverter) ToSchema(d *schema.ResourceData) error {
@@ -172,6 +176,12 @@ func (m *Inverter) ToSchema(d *schema.ResourceData) error {
 		},
 	})
 
+	if m.Parent != nil {
+		d.Set("parent", m.Parent.Id)
+	} else {
+		d.Set("parent", "")
+	}
+
 	d.Set("accumulated_energy", []map[string]any{m.AccumulatedEnergy.ToMap()})
 	d.Set("daily_energy", []map[string]any{m.DailyEnergy.ToMap()})
 	d.Set("raw_daily_energy", []map[string]any{m.RawDailyEnergy.ToMap()})
@@ -184,5 +194,8 @@ func (m *Inverter) ToSchema(d *schema.ResourceData) error {
 	d.Set("max_active_power", []map[string]any{m.MaxActivePower.ToMap()})
 	d.Set("energy_measurement_type", []map[string]any{m.EnergyMeasurementType.ToMap()})
 
+	m.extrasToSchema(d)
+	setAssetReferences(d, &m.InverterParams, &m.AssetExtras)
+
 	return nil
 }
@@ -3,10 +3,20 @@ package models
 import (
 	"encoding/json"
 	"fmt"
+	"math"
+	"strconv"
 
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/splightplatform/terraform-provider-splight/splight/electrical"
+	"github.com/splightplatform/terraform-provider-splight/splight/geo"
 )
 
+// lineLengthTolerance is the relative difference allowed between an explicit
+// length and the one measured on the geometry before warning about it
+const lineLengthTolerance = 0.01
+
 type LineParams struct {
 	AssetParams
 	ActivePower                  *AssetAttribute `json:"active_power"`
@@ -51,6 +61,7 @@ type LineParams struct {
 
 type Line struct {
 	LineParams
+	AssetExtras
 	Id string `json:"id"`
 }
 
@@ -76,11 +87,13 @@ func (m *Line) FromSchema(d *schema.ResourceData) error {
 	custom_timezone := d.Get("custom_timezone").(string)
 	geometryStr := d.Get("geometry").(string)
 
-	// Validate geometry JSON if it's set
+	// Reproject to WGS84, validate and normalize the geometry if it's set
 	if geometryStr != "" {
-		if err := validateJSONString(geometryStr); err != nil {
-			return fmt.Errorf("geometry must be a JSON encoded GeoJSON")
+		normalized, err := NormalizeGeometryCRS(geometryStr, d.Get("geometry_crs").(string))
+		if err != nil {
+			return fmt.Errorf("geometry must be a valid GeoJSON: %w", err)
 		}
+		geometryStr = normalized
 	}
 
 	// Check if geometryStr is empty and handle accordingly
@@ -100,10 +113,11 @@ func (m *Line) FromSchema(d *schema.ResourceData) error {
 			UseCustomTimezone: custom_timezone != "",
 			Tags:              tags,
 			Kind:              kind,
+			Parent:            convertParent(d),
 		},
 	}
 
-	diameter, err := convertAssetMetadata(d.Get("diameter").(*schema.Set).List())
+	diameter, err := convertAssetMetadata(d, "diameter")
 	if err != nil {
 		return fmt.Errorf("invalid diameter metadata: %w", err)
 	}
@@ -115,7 +129,7 @@ func (m *Line) FromSchema(d *schema.ResourceData) error {
 	}
 	m.LineParams.Diameter = *diameter
 
-	absorptivity, err := convertAssetMetadata(d.Get("absorptivity").(*schema.Set).List())
+	absorptivity, err := convertAssetMetadata(d, "absorptivity")
 	if err != nil {
 		return fmt.Errorf("invalid absorptivity metadata: %w", err)
 	}
@@ -127,7 +141,7 @@ func (m *Line) FromSchema(d *schema.ResourceData) error {
 	}
 	m.LineParams.Absorptivity = *absorptivity
 
-	atmosphere, err := convertAssetMetadata(d.Get("atmosphere").(*schema.Set).List())
+	atmosphere, err := convertAssetMetadata(d, "atmosphere")
 	if err != nil {
 		return fmt.Errorf("invalid atmosphere metadata: %w", err)
 	}
@@ -139,7 +153,7 @@ func (m *Line) FromSchema(d *schema.ResourceData) error {
 	}
 	m.LineParams.Atmosphere = *atmosphere
 
-	capacitance, err := convertAssetMetadata(d.Get("capacitance").(*schema.Set).List())
+	capacitance, err := convertAssetMetadata(d, "capacitance")
 	if err != nil {
 		return fmt.Errorf("invalid capacitance metadata: %w", err)
 	}
@@ -151,7 +165,7 @@ func (m *Line) FromSchema(d *schema.ResourceData) error {
 	}
 	m.LineParams.Capacitance = *capacitance
 
-	conductance, err := convertAssetMetadata(d.Get("conductance").(*schema.Set).List())
+	conductance, err := convertAssetMetadata(d, "conductance")
 	if err != nil {
 		return fmt.Errorf("invalid conductance metadata: %w", err)
 	}
@@ -163,7 +177,7 @@ func (m *Line) FromSchema(d *schema.ResourceData) error {
 	}
 	m.LineParams.Conductance = *conductance
 
-	emissivity, err := convertAssetMetadata(d.Get("emissivity").(*schema.Set).List())
+	emissivity, err := convertAssetMetadata(d, "emissivity")
 	if err != nil {
 		return fmt.Errorf("invalid emissivity metadata: %w", err)
 	}
@@ -175,7 +189,7 @@ func (m *Line) FromSchema(d *schema.ResourceData) error {
 	}
 	m.LineParams.Emissivity = *emissivity
 
-	length, err := convertAssetMetadata(d.Get("length").(*schema.Set).List())
+	length, err := convertAssetMetadata(d, "length")
 	if err != nil {
 		return fmt.Errorf("invalid length metadata: %w", err)
 	}
@@ -185,9 +199,18 @@ func (m *Line) FromSchema(d *schema.ResourceData) error {
 	if length.Name == "" {
 		length.Name = "length"
 	}
+	// An explicit length always takes precedence over the computed one
+	if d.Get("length_from_geometry").(bool) && !blockConfigured(d, "length") {
+		lengthKm, err := lineLengthFromGeometry(geometryStr)
+		if err != nil {
+			return fmt.Errorf("unable to compute length from geometry: %w", err)
+		}
+		length.Value = json.RawMessage(strconv.FormatFloat(lengthKm, 'f', -1, 64))
+		length.Unit = "km"
+	}
 	m.LineParams.Length = *length
 
-	maximumAllowedCurrent, err := convertAssetMetadata(d.Get("maximum_allowed_current").(*schema.Set).List())
+	maximumAllowedCurrent, err := convertAssetMetadata(d, "maximum_allowed_current")
 	if err != nil {
 		return fmt.Errorf("invalid maximum_allowed_current metadata: %w", err)
 	}
@@ -199,7 +222,7 @@ func (m *Line) FromSchema(d *schema.ResourceData) error {
 	}
 	m.LineParams.MaximumAllowedCurrent = *maximumAllowedCurrent
 
-	maximumAllowedPower, err := convertAssetMetadata(d.Get("maximum_allowed_power").(*schema.Set).List())
+	maximumAllowedPower, err := convertAssetMetadata(d, "maximum_allowed_power")
 	if err != nil {
 		return fmt.Errorf("invalid maximum_allowed_power metadata: %w", err)
 	}
@@ -211,7 +234,7 @@ func (m *Line) FromSchema(d *schema.ResourceData) error {
 	}
 	m.LineParams.MaximumAllowedPower = *maximumAllowedPower
 
-	maximumAllowedTemperature, err := convertAssetMetadata(d.Get("maximum_allowed_temperature").(*schema.Set).List())
+	maximumAllowedTemperature, err := convertAssetMetadata(d, "maximum_allowed_temperature")
 	if err != nil {
 		return fmt.Errorf("invalid maximum_allowed_temperature metadata: %w", err)
 	}
@@ -223,7 +246,7 @@ func (m *Line) FromSchema(d *schema.ResourceData) error {
 	}
 	m.LineParams.MaximumAllowedTemperature = *maximumAllowedTemperature
 
-	maximumAllowedTemperatureLTE, err := convertAssetMetadata(d.Get("maximum_allowed_temperature_lte").(*schema.Set).List())
+	maximumAllowedTemperatureLTE, err := convertAssetMetadata(d, "maximum_allowed_temperature_lte")
 	if err != nil {
 		return fmt.Errorf("invalid maximum_allowed_temperature_lte metadata: %w", err)
 	}
@@ -235,7 +258,7 @@ func (m *Line) FromSchema(d *schema.ResourceData) error {
 	}
 	m.LineParams.MaximumAllowedTemperatureLTE = *maximumAllowedTemperatureLTE
 
-	maximumAllowedTemperatureSTE, err := convertAssetMetadata(d.Get("maximum_allowed_temperature_ste").(*schema.Set).List())
+	maximumAllowedTemperatureSTE, err := convertAssetMetadata(d, "maximum_allowed_temperature_ste")
 	if err != nil {
 		return fmt.Errorf("invalid maximum_allowed_temperature_ste metadata: %w", err)
 	}
@@ -247,7 +270,7 @@ func (m *Line) FromSchema(d *schema.ResourceData) error {
 	}
 	m.LineParams.MaximumAllowedTemperatureSTE = *maximumAllowedTemperatureSTE
 
-	numberOfConductors, err := convertAssetMetadata(d.Get("number_of_conductors").(*schema.Set).List())
+	numberOfConductors, err := convertAssetMetadata(d, "number_of_conductors")
 	if err != nil {
 		return fmt.Errorf("invalid number_of_conductors metadata: %w", err)
 	}
@@ -259,7 +282,7 @@ func (m *Line) FromSchema(d *schema.ResourceData) error {
 	}
 	m.LineParams.NumberOfConductors = *numberOfConductors
 
-	reactance, err := convertAssetMetadata(d.Get("reactance").(*schema.Set).List())
+	reactance, err := convertAssetMetadata(d, "reactance")
 	if err != nil {
 		return fmt.Errorf("invalid reactance metadata: %w", err)
 	}
@@ -271,7 +294,7 @@ func (m *Line) FromSchema(d *schema.ResourceData) error {
 	}
 	m.LineParams.Reactance = *reactance
 
-	referenceResistance, err := convertAssetMetadata(d.Get("reference_resistance").(*schema.Set).List())
+	referenceResistance, err := convertAssetMetadata(d, "reference_resistance")
 	if err != nil {
 		return fmt.Errorf("invalid reference_resistance metadata: %w", err)
 	}
@@ -283,7 +306,7 @@ func (m *Line) FromSchema(d *schema.ResourceData) error {
 	}
 	m.LineParams.ReferenceResistance = *referenceResistance
 
-	resistance, err := convertAssetMetadata(d.Get("resistance").(*schema.Set).List())
+	resistance, err := convertAssetMetadata(d, "resistance")
 	if err != nil {
 		return fmt.Errorf("invalid resistance metadata: %w", err)
 	}
@@ -295,7 +318,7 @@ func (m *Line) FromSchema(d *schema.ResourceData) error {
 	}
 	m.LineParams.Resistance = *resistance
 
-	safetyMarginForPower, err := convertAssetMetadata(d.Get("safety_margin_for_power").(*schema.Set).List())
+	safetyMarginForPower, err := convertAssetMetadata(d, "safety_margin_for_power")
 	if err != nil {
 		return fmt.Errorf("invalid safety_margin_for_power metadata: %w", err)
 	}
@@ -307,7 +330,7 @@ func (m *Line) FromSchema(d *schema.ResourceData) error {
 	}
 	m.LineParams.SafetyMarginForPower = *safetyMarginForPower
 
-	susceptance, err := convertAssetMetadata(d.Get("susceptance").(*schema.Set).List())
+	susceptance, err := convertAssetMetadata(d, "susceptance")
 	if err != nil {
 		return fmt.Errorf("invalid susceptance metadata: %w", err)
 	}
@@ -319,7 +342,7 @@ func (m *Line) FromSchema(d *schema.ResourceData) error {
 	}
 	m.LineParams.Susceptance = *susceptance
 
-	temperatureCoeffResistance, err := convertAssetMetadata(d.Get("temperature_coeff_resistance").(*schema.Set).List())
+	temperatureCoeffResistance, err := convertAssetMetadata(d, "temperature_coeff_resistance")
 	if err != nil {
 		return fmt.Errorf("invalid temperature_coeff_resistance metadata: %w", err)
 	}
@@ -331,7 +354,7 @@ func (m *Line) FromSchema(d *schema.ResourceData) error {
 	}
 	m.LineParams.TemperatureCoeffResistance = *temperatureCoeffResistance
 
-	specificHeat, err := convertAssetMetadata(d.Get("specific_heat").(*schema.Set).List())
+	specificHeat, err := convertAssetMetadata(d, "specific_heat")
 	if err != nil {
 		return fmt.Errorf("invalid specific_heat metadata: %w", err)
 	}
@@ -343,7 +366,7 @@ func (m *Line) FromSchema(d *schema.ResourceData) error {
 	}
 	m.LineParams.SpecificHeat = *specificHeat
 
-	conductorMass, err := convertAssetMetadata(d.Get("conductor_mass").(*schema.Set).List())
+	conductorMass, err := convertAssetMetadata(d, "conductor_mass")
 	if err != nil {
 		return fmt.Errorf("invalid conductor_mass metadata: %w", err)
 	}
@@ -355,7 +378,7 @@ func (m *Line) FromSchema(d *schema.ResourceData) error {
 	}
 	m.LineParams.ConductorMass = *conductorMass
 
-	thermalElongationCoef, err := convertAssetMetadata(d.Get("thermal_elongation_coef").(*schema.Set).List())
+	thermalElongationCoef, err := convertAssetMetadata(d, "thermal_elongation_coef")
 	if err != nil {
 		return fmt.Errorf("invalid thermal_elongation_coef metadata: %w", err)
 	}
@@ -367,9 +390,124 @@ func (m *Line) FromSchema(d *schema.ResourceData) error {
 	}
 	m.LineParams.ThermalElongationCoef = *thermalElongationCoef
 
+	if conductorType := d.Get("conductor_type").(string); conductorType != "" {
+		if err := m.applyConductorType(d, conductorType); err != nil {
+			return err
+		}
+	}
+
+	return m.extrasFromSchema(d)
+}
+
+// applyConductorType fills the conductor metadata from the catalog,
+// except for the blocks that are set explicitly
+func (m *Line) applyConductorType(d *schema.ResourceData, name string) error {
+	conductorType, err := electrical.LookupConductorType(name)
+	if err != nil {
+		return err
+	}
+
+	metadata := map[string]*AssetMetadata{
+		"diameter":                     &m.LineParams.Diameter,
+		"reference_resistance":         &m.LineParams.ReferenceResistance,
+		"conductor_mass":               &m.LineParams.ConductorMass,
+		"specific_heat":                &m.LineParams.SpecificHeat,
+		"thermal_elongation_coef":      &m.LineParams.ThermalElongationCoef,
+		"temperature_coeff_resistance": &m.LineParams.TemperatureCoeffResistance,
+	}
+
+	for key, value := range conductorType.Metadata() {
+		if blockConfigured(d, key) {
+			continue
+		}
+		metadata[key].Value = json.RawMessage(strconv.FormatFloat(value, 'f', -1, 64))
+		metadata[key].Unit = electrical.ConductorUnits[key]
+	}
+
 	return nil
 }
 
+// lineLengthFromGeometry returns the geodesic length in km of the lines in a
+// GeoJSON geometry
+func lineLengthFromGeometry(geometryStr string) (float64, error) {
+	if geometryStr == "" {
+		return 0, fmt.Errorf("geometry is not set")
+	}
+
+	geometry, err := geo.ParseGeometry(geometryStr)
+	if err != nil {
+		return 0, err
+	}
+
+	meters, err := geometry.Length()
+	if err != nil {
+		return 0, err
+	}
+
+	return geo.Round(meters/1000, 3), nil
+}
+
+func (m *Line) ValidateConfig(config cty.Value) diag.Diagnostics {
+	fromGeometry, ok := configBool(config, "length_from_geometry")
+	if !ok || !fromGeometry {
+		return nil
+	}
+
+	if geometry := config.GetAttr("geometry"); geometry.IsKnown() && geometry.IsNull() {
+		return diag.Diagnostics{{
+			Severity:      diag.Error,
+			Summary:       "Missing geometry",
+			Detail:        "length_from_geometry requires a geometry with a LineString or MultiLineString.",
+			AttributePath: cty.GetAttrPath("length_from_geometry"),
+		}}
+	}
+
+	// Values that are unknown until apply can't be checked yet
+	geometryStr, ok := ConfigString(config, "geometry")
+	if !ok {
+		return nil
+	}
+	lengthValue, ok := configBlockValue(config, "length")
+	if !ok {
+		return nil
+	}
+	crs := config.GetAttr("geometry_crs")
+	if !crs.IsKnown() {
+		return nil
+	}
+	if !crs.IsNull() {
+		reprojected, err := ReprojectGeometry(geometryStr, crs.AsString())
+		if err != nil {
+			return nil
+		}
+		geometryStr = reprojected
+	}
+
+	computed, err := lineLengthFromGeometry(geometryStr)
+	if err != nil || computed == 0 {
+		return nil
+	}
+
+	var explicit float64
+	if err := json.Unmarshal(lengthValue, &explicit); err != nil {
+		return nil
+	}
+
+	if math.Abs(explicit-computed)/computed <= lineLengthTolerance {
+		return nil
+	}
+
+	return diag.Diagnostics{{
+		Severity: diag.Warning,
+		Summary:  "Line length differs from its geometry",
+		Detail: fmt.Sprintf(
+			"The length metadata is %g km but the geometry measures %g km (more than %g%% apart). The explicit length will be used.",
+			explicit, computed, lineLengthTolerance*100,
+		),
+		AttributePath: cty.GetAttrPath("length"),
+	}}
+}
+
 func (m *Line) ToSchema(d *schema.ResourceData) error {
 	d.SetId(m.Id)
 
@@ -403,6 +541,12 @@ func (m *Line) ToSchema(d *schema.ResourceData) error {
 		},
 	})
 
+	if m.Parent != nil {
+		d.Set("parent", m.Parent.Id)
+	} else {
+		d.Set("parent", "")
+	}
+
 	d.Set("active_power", []map[string]any{m.ActivePower.ToMap()})
 	d.Set("active_power_end", []map[string]any{m.ActivePowerEnd.ToMap()})
 	d.Set("ampacity", []map[string]any{m.Ampacity.ToMap()})
@@ -442,5 +586,8 @@ func (m *Line) ToSchema(d *schema.ResourceData) error {
 	d.Set("conductor_mass", []map[string]any{m.ConductorMass.ToMap()})
 	d.Set("thermal_elongation_coef", []map[string]any{m.ThermalElongationCoef.ToMap()})
 
+	m.extrasToSchema(d)
+	setAssetReferences(d, &m.LineParams, &m.AssetExtras)
+
 	return nil
 }
@@ -0,0 +1,97 @@
+package models
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/splightplatform/terraform-provider-splight/splight/geo"
+)
+
+type LineSegment struct {
+	Index              int
+	SpanLength         float64
+	Azimuth            float64
+	CumulativeDistance float64
+	Altitude           float64
+	Geometry           string
+}
+
+// LineSegments splits a line geometry into one segment per span (the section
+// between two consecutive vertices, usually two towers).
+type LineSegments struct {
+	Geometry string
+	Length   float64
+	Segments []LineSegment
+}
+
+func (m *LineSegments) FromSchema(d *schema.ResourceData) error {
+	m.Geometry = d.Get("geometry").(string)
+
+	geometry, err := geo.ParseGeometry(m.Geometry)
+	if err != nil {
+		return fmt.Errorf("geometry must be a JSON encoded GeoJSON: %w", err)
+	}
+
+	lines, err := geometry.LineStrings()
+	if err != nil {
+		return err
+	}
+	if len(lines) != 1 {
+		return fmt.Errorf("geometry must contain exactly one LineString, found %d", len(lines))
+	}
+
+	vertices := lines[0]
+	if len(vertices) < 2 {
+		return fmt.Errorf("LineString must have at least two positions")
+	}
+
+	m.Length = 0
+	m.Segments = make([]LineSegment, 0, len(vertices)-1)
+	for i := 1; i < len(vertices); i++ {
+		start, end := vertices[i-1], vertices[i]
+
+		spanLength, azimuth := geo.Inverse(start, end)
+		m.Length += spanLength
+
+		midpoint := geo.Midpoint(start, end)
+
+		// Altitude is stored as metadata, so the Point is always 2D
+		point := geo.NewPoint(geo.Position{
+			Lon: geo.Round(midpoint.Lon, 8),
+			Lat: geo.Round(midpoint.Lat, 8),
+		})
+
+		m.Segments = append(m.Segments, LineSegment{
+			Index:              i - 1,
+			SpanLength:         geo.Round(spanLength, 3),
+			Azimuth:            geo.Round(azimuth, 4),
+			CumulativeDistance: geo.Round(m.Length, 3),
+			Altitude:           geo.Round(midpoint.Alt, 3),
+			Geometry:           geo.NewGeometryCollection(point).String(),
+		})
+	}
+	m.Length = geo.Round(m.Length, 3)
+
+	return nil
+}
+
+func (m *LineSegments) ToSchema(d *schema.ResourceData) error {
+	var segmentsMap []map[string]any
+
+	for _, segment := range m.Segments {
+		segmentsMap = append(segmentsMap, map[string]any{
+			"index":               segment.Index,
+			"span_length":         segment.SpanLength,
+			"azimuth":             segment.Azimuth,
+			"cumulative_distance": segment.CumulativeDistance,
+			"altitude":            segment.Altitude,
+			"geometry":            segment.Geometry,
+		})
+	}
+
+	d.Set("length", m.Length)
+	d.Set("segments", segmentsMap)
+	d.SetId("line_segments")
+
+	return nil
+}
@@ -0,0 +1,169 @@
+package models
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+type LoadParams struct {
+	AssetParams
+	ActivePower        *AssetAttribute    `json:"active_power"`
+	ReactivePower      *AssetAttribute    `json:"reactive_power"`
+	RatedActivePower   AssetMetadata      `json:"rated_active_power"`
+	RatedReactivePower AssetMetadata      `json:"rated_reactive_power"`
+	Bus                *AssetRelationship `json:"bus,omitempty"`
+}
+
+type Load struct {
+	LoadParams
+	AssetExtras
+	Id string `json:"id"`
+}
+
+func (m *Load) GetId() string {
+	return m.Id
+}
+
+func (m *Load) GetParams() Params {
+	return &m.LoadParams
+}
+
+func (m *Load) ResourcePath() string {
+	return "v3/engine/asset/loads/"
+}
+
+func (m *Load) FromSchema(d *schema.ResourceData) error {
+	m.Id = d.Id()
+
+	kind := convertSingleQueryFilter(d.Get("kind").(*schema.Set).List())
+	tags := convertQueryFilters(d.Get("tags").(*schema.Set).List())
+
+	// Get values of custom_timezone and geometry
+	custom_timezone := d.Get("custom_timezone").(string)
+	geometryStr := d.Get("geometry").(string)
+	busId := d.Get("bus").(string)
+
+	var busRel *AssetRelationship = nil
+	if busId != "" {
+		busRel = &AssetRelationship{
+			RelatedAssetId: ResourceId{
+				Id: busId,
+			},
+		}
+	}
+
+	// Reproject to WGS84, validate and normalize the geometry if it's set
+	if geometryStr != "" {
+		normalized, err := NormalizeGeometryCRS(geometryStr, d.Get("geometry_crs").(string))
+		if err != nil {
+			return fmt.Errorf("geometry must be a valid GeoJSON: %w", err)
+		}
+		geometryStr = normalized
+	}
+
+	// Check if geometryStr is empty and handle accordingly
+	var geometry *json.RawMessage
+	if geometryStr != "" {
+		// Convert string to json.RawMessage
+		raw := json.RawMessage(geometryStr)
+		geometry = &raw
+	}
+
+	m.LoadParams = LoadParams{
+		AssetParams: AssetParams{
+			Name:              d.Get("name").(string),
+			Description:       d.Get("description").(string),
+			Geometry:          geometry,
+			CustomTimezone:    custom_timezone,
+			UseCustomTimezone: custom_timezone != "",
+			Tags:              tags,
+			Kind:              kind,
+			Parent:            convertParent(d),
+		},
+		Bus: busRel,
+	}
+
+	ratedActivePower, err := convertAssetMetadata(d, "rated_active_power")
+	if err != nil {
+		return fmt.Errorf("invalid rated active power metadata: %w", err)
+	}
+	if ratedActivePower.Type == "" {
+		ratedActivePower.Type = "Number"
+	}
+	if ratedActivePower.Name == "" {
+		ratedActivePower.Name = "rated_active_power"
+	}
+	m.LoadParams.RatedActivePower = *ratedActivePower
+
+	ratedReactivePower, err := convertAssetMetadata(d, "rated_reactive_power")
+	if err != nil {
+		return fmt.Errorf("invalid rated reactive power metadata: %w", err)
+	}
+	if ratedReactivePower.Type == "" {
+		ratedReactivePower.Type = "Number"
+	}
+	if ratedReactivePower.Name == "" {
+		ratedReactivePower.Name = "rated_reactive_power"
+	}
+	m.LoadParams.RatedReactivePower = *ratedReactivePower
+
+	return m.extrasFromSchema(d)
+}
+
+func (m *Load) ToSchema(d *schema.ResourceData) error {
+	d.SetId(m.Id)
+
+	d.Set("name", m.AssetParams.Name)
+	d.Set("description", m.AssetParams.Description)
+
+	if m.Bus != nil {
+		d.Set("bus", m.Bus.RelatedAssetId.Id)
+	} else {
+		d.Set("bus", "")
+	}
+
+	var geometryStr string
+	if m.Geometry != nil {
+		geometryStr = string(*m.Geometry)
+	} else {
+		geometryStr = ""
+	}
+	d.Set("geometry", geometryStr)
+
+	d.Set("timezone", m.Timezone)
+	d.Set("custom_timezone", m.CustomTimezone)
+
+	var tags []map[string]any
+	for _, tag := range m.AssetParams.Tags {
+		tags = append(tags, map[string]any{
+			"id":   tag.Id,
+			"name": tag.Name,
+		})
+	}
+	d.Set("tags", tags)
+
+	d.Set("kind", []map[string]any{
+		{
+			"id":   m.AssetParams.Kind.Id,
+			"name": m.AssetParams.Kind.Name,
+		},
+	})
+
+	if m.Parent != nil {
+		d.Set("parent", m.Parent.Id)
+	} else {
+		d.Set("parent", "")
+	}
+
+	d.Set("active_power", []map[string]any{m.ActivePower.ToMap()})
+	d.Set("reactive_power", []map[string]any{m.ReactivePower.ToMap()})
+	d.Set("rated_active_power", []map[string]any{m.RatedActivePower.ToMap()})
+	d.Set("rated_reactive_power", []map[string]any{m.RatedReactivePower.ToMap()})
+
+	m.extrasToSchema(d)
+	setAssetReferences(d, &m.LoadParams, &m.AssetExtras)
+
+	return nil
+}
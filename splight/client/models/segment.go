@@ -22,6 +22,7 @@ type SegmentParams struct {
 
 type Segment struct {
 	SegmentParams
+	AssetExtras
 	Id string `json:"id"`
 }
 
@@ -58,11 +59,13 @@ func (m *Segment) FromSchema(d *schema.ResourceData) error {
 	custom_timezone := d.Get("custom_timezone").(string)
 	geometryStr := d.Get("geometry").(string)
 
-	// Validate geometry JSON if it's set
+	// Reproject to WGS84, validate and normalize the geometry if it's set
 	if geometryStr != "" {
-		if err := validateJSONString(geometryStr); err != nil {
-			return fmt.Errorf("geometry must be a JSON encoded GeoJSON")
+		normalized, err := NormalizeGeometryCRS(geometryStr, d.Get("geometry_crs").(string))
+		if err != nil {
+			return fmt.Errorf("geometry must be a valid GeoJSON: %w", err)
 		}
+		geometryStr = normalized
 	}
 
 	// Check if geometryStr is empty and handle accordingly
@@ -82,10 +85,11 @@ func (m *Segment) FromSchema(d *schema.ResourceData) error {
 			UseCustomTimezone: custom_timezone != "",
 			Tags:              tags,
 			Kind:              kind,
+			Parent:            convertParent(d),
 		},
 	}
 
-	altitude, err := convertAssetMetadata(d.Get("altitude").(*schema.Set).List())
+	altitude, err := convertAssetMetadata(d, "altitude")
 	if err != nil {
 		return fmt.Errorf("invalid altitude metadata: %w", err)
 	}
@@ -97,7 +101,7 @@ func (m *Segment) FromSchema(d *schema.ResourceData) error {
 	}
 	m.SegmentParams.Altitude = *altitude
 
-	azimuth, err := convertAssetMetadata(d.Get("azimuth").(*schema.Set).List())
+	azimuth, err := convertAssetMetadata(d, "azimuth")
 	if err != nil {
 		return fmt.Errorf("invalid azimuth metadata: %w", err)
 	}
@@ -109,7 +113,7 @@ func (m *Segment) FromSchema(d *schema.ResourceData) error {
 	}
 	m.SegmentParams.Azimuth = *azimuth
 
-	cumulativeDistance, err := convertAssetMetadata(d.Get("cumulative_distance").(*schema.Set).List())
+	cumulativeDistance, err := convertAssetMetadata(d, "cumulative_distance")
 	if err != nil {
 		return fmt.Errorf("invalid cumulative distance metadata: %w", err)
 	}
@@ -121,7 +125,7 @@ func (m *Segment) FromSchema(d *schema.ResourceData) error {
 	}
 	m.SegmentParams.CumulativeDistance = *cumulativeDistance
 
-	referenceSag, err := convertAssetMetadata(d.Get("reference_sag").(*schema.Set).List())
+	referenceSag, err := convertAssetMetadata(d, "reference_sag")
 	if err != nil {
 		return fmt.Errorf("invalid reference sag metadata: %w", err)
 	}
@@ -133,7 +137,7 @@ func (m *Segment) FromSchema(d *schema.ResourceData) error {
 	}
 	m.SegmentParams.ReferenceSag = *referenceSag
 
-	referenceTemperature, err := convertAssetMetadata(d.Get("reference_temperature").(*schema.Set).List())
+	referenceTemperature, err := convertAssetMetadata(d, "reference_temperature")
 	if err != nil {
 		return fmt.Errorf("invalid reference temperature metadata: %w", err)
 	}
@@ -145,7 +149,7 @@ func (m *Segment) FromSchema(d *schema.ResourceData) error {
 	}
 	m.SegmentParams.ReferenceTemperature = *referenceTemperature
 
-	spanLength, err := convertAssetMetadata(d.Get("span_length").(*schema.Set).List())
+	spanLength, err := convertAssetMetadata(d, "span_length")
 	if err != nil {
 		return fmt.Errorf("invalid span length metadata: %w", err)
 	}
@@ -157,7 +161,7 @@ func (m *Segment) FromSchema(d *schema.ResourceData) error {
 	}
 	m.SegmentParams.SpanLength = *spanLength
 
-	return nil
+	return m.extrasFromSchema(d)
 }
 
 func (m *Segment) ToSchema(d *schema.ResourceData) error {
@@ -193,6 +197,12 @@ func (m *Segment) ToSchema(d *schema.ResourceData) error {
 		},
 	})
 
+	if m.Parent != nil {
+		d.Set("parent", m.Parent.Id)
+	} else {
+		d.Set("parent", "")
+	}
+
 	d.Set("temperature", []map[string]any{m.Temperature.ToMap()})
 	d.Set("wind_speed", []map[string]any{m.WindSpeed.ToMap()})
 	d.Set("wind_direction", []map[string]any{m.WindDirection.ToMap()})
@@ -203,5 +213,8 @@ func (m *Segment) ToSchema(d *schema.ResourceData) error {
 	d.Set("reference_temperature", []map[string]any{m.ReferenceTemperature.ToMap()})
 	d.Set("span_length", []map[string]any{m.SpanLength.ToMap()})
 
+	m.extrasToSchema(d)
+	setAssetReferences(d, &m.SegmentParams, &m.AssetExtras)
+
 	return nil
 }
@@ -13,6 +13,7 @@ type SlackGeneratorParams struct {
 
 type SlackGenerator struct {
 	SlackGeneratorParams
+	AssetExtras
 	Id string `json:"id"`
 }
 
@@ -35,10 +36,14 @@ func (m *SlackGenerator) FromSchema(d *schema.ResourceData) error {
 
 	custom_timezone := d.Get("custom_timezone").(string)
 
-	// Validate geometry JSON
+	// Validate and normalize geometry JSON
 	geometryStr := d.Get("geometry").(string)
-	if err := validateJSONString(geometryStr); err != nil {
-		return fmt.Errorf("geometry field contains %w", err)
+	if geometryStr != "" {
+		normalized, err := NormalizeGeometryCRS(geometryStr, d.Get("geometry_crs").(string))
+		if err != nil {
+			return fmt.Errorf("geometry must be a valid GeoJSON: %w", err)
+		}
+		geometryStr = normalized
 	}
 
 	geometry := json.RawMessage(geometryStr)
@@ -51,10 +56,11 @@ func (m *SlackGenerator) FromSchema(d *schema.ResourceData) error {
 			UseCustomTimezone: custom_timezone != "",
 			Tags:              tags,
 			Kind:              kind,
+			Parent:            convertParent(d),
 		},
 	}
 
-	return nil
+	return m.extrasFromSchema(d)
 }
 
 func (m *SlackGenerator) ToSchema(d *schema.ResourceData) error {
@@ -90,5 +96,14 @@ func (m *SlackGenerator) ToSchema(d *schema.ResourceData) error {
 		},
 	})
 
+	if m.Parent != nil {
+		d.Set("parent", m.Parent.Id)
+	} else {
+		d.Set("parent", "")
+	}
+
+	m.extrasToSchema(d)
+	setAssetReferences(d, &m.SlackGeneratorParams, &m.AssetExtras)
+
 	return nil
 }
@@ -15,6 +15,7 @@ type SlackLineParams struct {
 
 type SlackLine struct {
 	SlackLineParams
+	AssetExtras
 	Id string `json:"id"`
 }
 
@@ -40,11 +41,13 @@ func (m *SlackLine) FromSchema(d *schema.ResourceData) error {
 	custom_timezone := d.Get("custom_timezone").(string)
 	geometryStr := d.Get("geometry").(string)
 
-	// Validate geometry JSON if it's set
+	// Reproject to WGS84, validate and normalize the geometry if it's set
 	if geometryStr != "" {
-		if err := validateJSONString(geometryStr); err != nil {
-			return fmt.Errorf("geometry must be a JSON encoded GeoJSON")
+		normalized, err := NormalizeGeometryCRS(geometryStr, d.Get("geometry_crs").(string))
+		if err != nil {
+			return fmt.Errorf("geometry must be a valid GeoJSON: %w", err)
 		}
+		geometryStr = normalized
 	}
 
 	// Check if geometryStr is empty and handle accordingly
@@ -64,10 +67,11 @@ func (m *SlackLine) FromSchema(d *schema.ResourceData) error {
 			UseCustomTimezone: custom_timezone != "",
 			Tags:              tags,
 			Kind:              kind,
+			Parent:            convertParent(d),
 		},
 	}
 
-	return nil
+	return m.extrasFromSchema(d)
 }
 
 func (m *SlackLine) ToSchema(d *schema.ResourceData) error {
@@ -104,5 +108,14 @@ func (m *SlackLine) ToSchema(d *schema.ResourceData) error {
 		},
 	})
 
+	if m.Parent != nil {
+		d.Set("parent", m.Parent.Id)
+	} else {
+		d.Set("parent", "")
+	}
+
+	m.extrasToSchema(d)
+	setAssetReferences(d, &m.SlackLineParams, &m.AssetExtras)
+
 	return nil
 }
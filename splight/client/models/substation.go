@@ -0,0 +1,158 @@
+package models
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+type SubstationParams struct {
+	AssetParams
+}
+
+type Substation struct {
+	SubstationParams
+	AssetExtras
+	Id string `json:"id"`
+}
+
+func (m *Substation) GetId() string {
+	return m.Id
+}
+
+func (m *Substation) GetParams() Params {
+	return &m.SubstationParams
+}
+
+func (m *Substation) ResourcePath() string {
+	return "v3/engine/asset/substations/"
+}
+
+func (m *Substation) FromSchema(d *schema.ResourceData) error {
+	m.Id = d.Id()
+
+	kind := convertSingleQueryFilter(d.Get("kind").(*schema.Set).List())
+	tags := convertQueryFilters(d.Get("tags").(*schema.Set).List())
+
+	// Get values of custom_timezone and geometry
+	custom_timezone := d.Get("custom_timezone").(string)
+	geometryStr := d.Get("geometry").(string)
+
+	// Reproject to WGS84, validate and normalize the geometry if it's set
+	if geometryStr != "" {
+		normalized, err := NormalizeGeometryCRS(geometryStr, d.Get("geometry_crs").(string))
+		if err != nil {
+			return fmt.Errorf("geometry must be a valid GeoJSON: %w", err)
+		}
+		geometryStr = normalized
+
+		// Substations are areas, their geometry outlines the yard
+		if err := ValidateGeometryTypes(geometryStr, "Polygon", "MultiPolygon"); err != nil {
+			return err
+		}
+	}
+
+	// Check if geometryStr is empty and handle accordingly
+	var geometry *json.RawMessage
+	if geometryStr != "" {
+		// Convert string to json.RawMessage
+		raw := json.RawMessage(geometryStr)
+		geometry = &raw
+	}
+
+	m.SubstationParams = SubstationParams{
+		AssetParams: AssetParams{
+			Name:              d.Get("name").(string),
+			Description:       d.Get("description").(string),
+			Geometry:          geometry,
+			CustomTimezone:    custom_timezone,
+			UseCustomTimezone: custom_timezone != "",
+			Tags:              tags,
+			Kind:              kind,
+			Parent:            convertParent(d),
+		},
+	}
+
+	return m.extrasFromSchema(d)
+}
+
+// ValidateConfig checks at plan time that the geometry of the substation is
+// an area. Invalid geometries are reported by the geometry validation.
+func (m *Substation) ValidateConfig(config cty.Value) diag.Diagnostics {
+	geometryStr, ok := ConfigString(config, "geometry")
+	if !ok || geometryStr == "" {
+		return nil
+	}
+	crs := config.GetAttr("geometry_crs")
+	if !crs.IsKnown() {
+		return nil
+	}
+
+	var crsStr string
+	if !crs.IsNull() {
+		crsStr = crs.AsString()
+	}
+	normalized, err := NormalizeGeometryCRS(geometryStr, crsStr)
+	if err != nil {
+		return nil
+	}
+
+	if err := ValidateGeometryTypes(normalized, "Polygon", "MultiPolygon"); err != nil {
+		return diag.Diagnostics{{
+			Severity:      diag.Error,
+			Summary:       "Invalid substation geometry",
+			Detail:        err.Error(),
+			AttributePath: cty.GetAttrPath("geometry"),
+		}}
+	}
+
+	return nil
+}
+
+func (m *Substation) ToSchema(d *schema.ResourceData) error {
+	d.SetId(m.Id)
+
+	d.Set("name", m.AssetParams.Name)
+	d.Set("description", m.AssetParams.Description)
+
+	var geometryStr string
+	if m.Geometry != nil {
+		geometryStr = string(*m.Geometry)
+	} else {
+		geometryStr = ""
+	}
+	d.Set("geometry", geometryStr)
+
+	d.Set("timezone", m.Timezone)
+	d.Set("custom_timezone", m.CustomTimezone)
+
+	var tags []map[string]any
+	for _, tag := range m.AssetParams.Tags {
+		tags = append(tags, map[string]any{
+			"id":   tag.Id,
+			"name": tag.Name,
+		})
+	}
+	d.Set("tags", tags)
+
+	d.Set("kind", []map[string]any{
+		{
+			"id":   m.AssetParams.Kind.Id,
+			"name": m.AssetParams.Kind.Name,
+		},
+	})
+
+	if m.Parent != nil {
+		d.Set("parent", m.Parent.Id)
+	} else {
+		d.Set("parent", "")
+	}
+
+	m.extrasToSchema(d)
+	setAssetReferences(d, &m.SubstationParams, &m.AssetExtras)
+
+	return nil
+}
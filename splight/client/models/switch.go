@@ -0,0 +1,171 @@
+package models
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+type SwitchParams struct {
+	AssetParams
+	SwitchStatus *AssetAttribute    `json:"switch_status"`
+	NormallyOpen AssetMetadata      `json:"normally_open"`
+	BusFrom      *AssetRelationship `json:"bus_from,omitempty"`
+	BusTo        *AssetRelationship `json:"bus_to,omitempty"`
+}
+
+type Switch struct {
+	SwitchParams
+	AssetExtras
+	Id string `json:"id"`
+}
+
+func (m *Switch) GetId() string {
+	return m.Id
+}
+
+func (m *Switch) GetParams() Params {
+	return &m.SwitchParams
+}
+
+func (m *Switch) ResourcePath() string {
+	return "v3/engine/asset/switches/"
+}
+
+func (m *Switch) FromSchema(d *schema.ResourceData) error {
+	m.Id = d.Id()
+
+	kind := convertSingleQueryFilter(d.Get("kind").(*schema.Set).List())
+	tags := convertQueryFilters(d.Get("tags").(*schema.Set).List())
+
+	// Get values of custom_timezone and geometry
+	custom_timezone := d.Get("custom_timezone").(string)
+	geometryStr := d.Get("geometry").(string)
+	busFromId := d.Get("bus_from").(string)
+	busToId := d.Get("bus_to").(string)
+
+	var busFromRel *AssetRelationship = nil
+	if busFromId != "" {
+		busFromRel = &AssetRelationship{
+			RelatedAssetId: ResourceId{
+				Id: busFromId,
+			},
+		}
+	}
+
+	var busToRel *AssetRelationship = nil
+	if busToId != "" {
+		busToRel = &AssetRelationship{
+			RelatedAssetId: ResourceId{
+				Id: busToId,
+			},
+		}
+	}
+
+	// Reproject to WGS84, validate and normalize the geometry if it's set
+	if geometryStr != "" {
+		normalized, err := NormalizeGeometryCRS(geometryStr, d.Get("geometry_crs").(string))
+		if err != nil {
+			return fmt.Errorf("geometry must be a valid GeoJSON: %w", err)
+		}
+		geometryStr = normalized
+	}
+
+	// Check if geometryStr is empty and handle accordingly
+	var geometry *json.RawMessage
+	if geometryStr != "" {
+		// Convert string to json.RawMessage
+		raw := json.RawMessage(geometryStr)
+		geometry = &raw
+	}
+
+	m.SwitchParams = SwitchParams{
+		AssetParams: AssetParams{
+			Name:              d.Get("name").(string),
+			Description:       d.Get("description").(string),
+			Geometry:          geometry,
+			CustomTimezone:    custom_timezone,
+			UseCustomTimezone: custom_timezone != "",
+			Tags:              tags,
+			Kind:              kind,
+			Parent:            convertParent(d),
+		},
+		BusFrom: busFromRel,
+		BusTo:   busToRel,
+	}
+
+	normallyOpen, err := convertAssetMetadata(d, "normally_open")
+	if err != nil {
+		return fmt.Errorf("invalid normally open metadata: %w", err)
+	}
+	if normallyOpen.Type == "" {
+		normallyOpen.Type = "Boolean"
+	}
+	if normallyOpen.Name == "" {
+		normallyOpen.Name = "normally_open"
+	}
+	m.SwitchParams.NormallyOpen = *normallyOpen
+
+	return m.extrasFromSchema(d)
+}
+
+func (m *Switch) ToSchema(d *schema.ResourceData) error {
+	d.SetId(m.Id)
+
+	d.Set("name", m.AssetParams.Name)
+	d.Set("description", m.AssetParams.Description)
+
+	if m.BusFrom != nil {
+		d.Set("bus_from", m.BusFrom.RelatedAssetId.Id)
+	} else {
+		d.Set("bus_from", "")
+	}
+
+	if m.BusTo != nil {
+		d.Set("bus_to", m.BusTo.RelatedAssetId.Id)
+	} else {
+		d.Set("bus_to", "")
+	}
+
+	var geometryStr string
+	if m.Geometry != nil {
+		geometryStr = string(*m.Geometry)
+	} else {
+		geometryStr = ""
+	}
+	d.Set("geometry", geometryStr)
+
+	d.Set("timezone", m.Timezone)
+	d.Set("custom_timezone", m.CustomTimezone)
+
+	var tags []map[string]any
+	for _, tag := range m.AssetParams.Tags {
+		tags = append(tags, map[string]any{
+			"id":   tag.Id,
+			"name": tag.Name,
+		})
+	}
+	d.Set("tags", tags)
+
+	d.Set("kind", []map[string]any{
+		{
+			"id":   m.AssetParams.Kind.Id,
+			"name": m.AssetParams.Kind.Name,
+		},
+	})
+
+	if m.Parent != nil {
+		d.Set("parent", m.Parent.Id)
+	} else {
+		d.Set("parent", "")
+	}
+
+	d.Set("switch_status", []map[string]any{m.SwitchStatus.ToMap()})
+	d.Set("normally_open", []map[string]any{m.NormallyOpen.ToMap()})
+
+	m.extrasToSchema(d)
+	setAssetReferences(d, &m.SwitchParams, &m.AssetExtras)
+
+	return nil
+}
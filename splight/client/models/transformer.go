@@ -3,10 +3,19 @@ package models
 import (
 	"encoding/json"
 	"fmt"
+	"math"
+	"strconv"
 
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/splightplatform/terraform-provider-splight/splight/electrical"
 )
 
+// transformerTypeTolerance is the relative difference allowed between an
+// explicit metadata value and the one derived from the standard type
+const transformerTypeTolerance = 0.001
+
 type TransformerParams struct {
 	AssetParams
 	ActivePowerHV         *AssetAttribute `json:"active_power_hv"`
@@ -36,6 +45,7 @@ type TransformerParams struct {
 
 type Transformer struct {
 	TransformerParams
+	AssetExtras
 	Id string `json:"id"`
 }
 
@@ -61,11 +71,13 @@ func (m *Transformer) FromSchema(d *schema.ResourceData) error {
 	custom_timezone := d.Get("custom_timezone").(string)
 	geometryStr := d.Get("geometry").(string)
 
-	// Validate geometry JSON if it's set
+	// Reproject to WGS84, validate and normalize the geometry if it's set
 	if geometryStr != "" {
-		if err := validateJSONString(geometryStr); err != nil {
-			return fmt.Errorf("geometry must be a JSON encoded GeoJSON")
+		normalized, err := NormalizeGeometryCRS(geometryStr, d.Get("geometry_crs").(string))
+		if err != nil {
+			return fmt.Errorf("geometry must be a valid GeoJSON: %w", err)
 		}
+		geometryStr = normalized
 	}
 
 	// Check if geometryStr is empty and handle accordingly
@@ -85,10 +97,11 @@ func (m *Transformer) FromSchema(d *schema.ResourceData) error {
 			UseCustomTimezone: custom_timezone != "",
 			Tags:              tags,
 			Kind:              kind,
+			Parent:            convertParent(d),
 		},
 	}
 
-	tapPos, err := convertAssetMetadata(d.Get("tap_pos").(*schema.Set).List())
+	tapPos, err := convertAssetMetadata(d, "tap_pos")
 	if err != nil {
 		return fmt.Errorf("invalid tapPos metadata: %w", err)
 	}
@@ -100,7 +113,7 @@ func (m *Transformer) FromSchema(d *schema.ResourceData) error {
 	}
 	m.TransformerParams.TapPos = *tapPos
 
-	xnOhm, err := convertAssetMetadata(d.Get("xn_ohm").(*schema.Set).List())
+	xnOhm, err := convertAssetMetadata(d, "xn_ohm")
 	if err != nil {
 		return fmt.Errorf("invalid xnOhm metadata: %w", err)
 	}
@@ -112,7 +125,7 @@ func (m *Transformer) FromSchema(d *schema.ResourceData) error {
 	}
 	m.TransformerParams.XnOhm = *xnOhm
 
-	standardType, err := convertAssetMetadata(d.Get("standard_type").(*schema.Set).List())
+	standardType, err := convertAssetMetadata(d, "standard_type")
 	if err != nil {
 		return fmt.Errorf("invalid standardType metadata: %w", err)
 	}
@@ -124,7 +137,7 @@ func (m *Transformer) FromSchema(d *schema.ResourceData) error {
 	}
 	m.TransformerParams.StandardType = *standardType
 
-	capacitance, err := convertAssetMetadata(d.Get("capacitance").(*schema.Set).List())
+	capacitance, err := convertAssetMetadata(d, "capacitance")
 	if err != nil {
 		return fmt.Errorf("invalid capacitance metadata: %w", err)
 	}
@@ -136,7 +149,7 @@ func (m *Transformer) FromSchema(d *schema.ResourceData) error {
 	}
 	m.TransformerParams.Capacitance = *capacitance
 
-	conductance, err := convertAssetMetadata(d.Get("conductance").(*schema.Set).List())
+	conductance, err := convertAssetMetadata(d, "conductance")
 	if err != nil {
 		return fmt.Errorf("invalid conductance metadata: %w", err)
 	}
@@ -148,7 +161,7 @@ func (m *Transformer) FromSchema(d *schema.ResourceData) error {
 	}
 	m.TransformerParams.Conductance = *conductance
 
-	maximumAllowedCurrent, err := convertAssetMetadata(d.Get("maximum_allowed_current").(*schema.Set).List())
+	maximumAllowedCurrent, err := convertAssetMetadata(d, "maximum_allowed_current")
 	if err != nil {
 		return fmt.Errorf("invalid maximumAllowedCurrent metadata: %w", err)
 	}
@@ -160,7 +173,7 @@ func (m *Transformer) FromSchema(d *schema.ResourceData) error {
 	}
 	m.TransformerParams.MaximumAllowedCurrent = *maximumAllowedCurrent
 
-	maximumAllowedPower, err := convertAssetMetadata(d.Get("maximum_allowed_power").(*schema.Set).List())
+	maximumAllowedPower, err := convertAssetMetadata(d, "maximum_allowed_power")
 	if err != nil {
 		return fmt.Errorf("invalid maximumAllowedPower metadata: %w", err)
 	}
@@ -172,7 +185,7 @@ func (m *Transformer) FromSchema(d *schema.ResourceData) error {
 	}
 	m.TransformerParams.MaximumAllowedPower = *maximumAllowedPower
 
-	reactance, err := convertAssetMetadata(d.Get("reactance").(*schema.Set).List())
+	reactance, err := convertAssetMetadata(d, "reactance")
 	if err != nil {
 		return fmt.Errorf("invalid reactance metadata: %w", err)
 	}
@@ -184,7 +197,7 @@ func (m *Transformer) FromSchema(d *schema.ResourceData) error {
 	}
 	m.TransformerParams.Reactance = *reactance
 
-	resistance, err := convertAssetMetadata(d.Get("resistance").(*schema.Set).List())
+	resistance, err := convertAssetMetadata(d, "resistance")
 	if err != nil {
 		return fmt.Errorf("invalid resistance metadata: %w", err)
 	}
@@ -196,7 +209,7 @@ func (m *Transformer) FromSchema(d *schema.ResourceData) error {
 	}
 	m.TransformerParams.Resistance = *resistance
 
-	safetyMarginForPower, err := convertAssetMetadata(d.Get("safety_margin_for_power").(*schema.Set).List())
+	safetyMarginForPower, err := convertAssetMetadata(d, "safety_margin_for_power")
 	if err != nil {
 		return fmt.Errorf("invalid safetyMarginForPower metadata: %w", err)
 	}
@@ -208,7 +221,87 @@ func (m *Transformer) FromSchema(d *schema.ResourceData) error {
 	}
 	m.TransformerParams.SafetyMarginForPower = *safetyMarginForPower
 
-	return nil
+	if transformerType, ok := transformerStandardType(standardType.Value); ok {
+		m.applyTransformerType(d, transformerType)
+	}
+
+	return m.extrasFromSchema(d)
+}
+
+// transformerStandardType returns the catalog entry named by the standard_type
+// metadata value. Names outside the catalog are kept as free text.
+func transformerStandardType(value json.RawMessage) (electrical.TransformerType, bool) {
+	var name string
+	if err := json.Unmarshal(value, &name); err != nil || name == "" {
+		return electrical.TransformerType{}, false
+	}
+
+	transformerType, err := electrical.LookupTransformerType(name)
+	if err != nil {
+		return electrical.TransformerType{}, false
+	}
+
+	return transformerType, true
+}
+
+// applyTransformerType fills the electrical metadata derived from the standard
+// type, keeping any block set explicitly in the configuration
+func (m *Transformer) applyTransformerType(d *schema.ResourceData, transformerType electrical.TransformerType) {
+	metadata := map[string]*AssetMetadata{
+		"resistance":            &m.TransformerParams.Resistance,
+		"reactance":             &m.TransformerParams.Reactance,
+		"conductance":           &m.TransformerParams.Conductance,
+		"maximum_allowed_power": &m.TransformerParams.MaximumAllowedPower,
+	}
+
+	for key, value := range transformerType.Metadata() {
+		if blockConfigured(d, key) {
+			continue
+		}
+		metadata[key].Type = "Number"
+		metadata[key].Value = json.RawMessage(strconv.FormatFloat(value, 'f', -1, 64))
+		metadata[key].Unit = electrical.TransformerUnits[key]
+	}
+}
+
+func (m *Transformer) ValidateConfig(config cty.Value) diag.Diagnostics {
+	standardType, ok := configBlockValue(config, "standard_type")
+	if !ok {
+		return nil
+	}
+
+	transformerType, ok := transformerStandardType(standardType)
+	if !ok {
+		return nil
+	}
+
+	var diags diag.Diagnostics
+	for _, key := range []string{"resistance", "reactance", "conductance", "maximum_allowed_power"} {
+		value, ok := configBlockValue(config, key)
+		if !ok {
+			continue
+		}
+
+		derived := transformerType.Metadata()[key]
+		var explicit float64
+		if err := json.Unmarshal(value, &explicit); err == nil {
+			if explicit == derived || (derived != 0 && math.Abs(explicit-derived)/math.Abs(derived) <= transformerTypeTolerance) {
+				continue
+			}
+		}
+
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Warning,
+			Summary:  "Transformer metadata overrides its standard type",
+			Detail: fmt.Sprintf(
+				"The standard type derives %s = %g %s but the configuration sets %s. The explicit value will be used.",
+				key, derived, electrical.TransformerUnits[key], value,
+			),
+			AttributePath: cty.GetAttrPath(key),
+		})
+	}
+
+	return diags
 }
 
 func (m *Transformer) ToSchema(d *schema.ResourceData) error {
@@ -244,6 +337,12 @@ func (m *Transformer) ToSchema(d *schema.ResourceData) error {
 		},
 	})
 
+	if m.Parent != nil {
+		d.Set("parent", m.Parent.Id)
+	} else {
+		d.Set("parent", "")
+	}
+
 	d.Set("active_power_hv", []map[string]any{
 		m.ActivePowerHV.ToMap(),
 	})
@@ -332,5 +431,8 @@ func (m *Transformer) ToSchema(d *schema.ResourceData) error {
 		m.SafetyMarginForPower.ToMap(),
 	})
 
+	m.extrasToSchema(d)
+	setAssetReferences(d, &m.TransformerParams, &m.AssetExtras)
+
 	return nil
 }
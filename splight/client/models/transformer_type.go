@@ -0,0 +1,56 @@
+package models
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/splightplatform/terraform-provider-splight/splight/electrical"
+)
+
+// TransformerType describes a standard transformer from the embedded catalog
+type TransformerType struct {
+	Name string
+	electrical.TransformerType
+}
+
+func (m *TransformerType) FromSchema(d *schema.ResourceData) error {
+	m.Name = d.Get("name").(string)
+
+	transformerType, err := electrical.LookupTransformerType(m.Name)
+	if err != nil {
+		return err
+	}
+	m.TransformerType = transformerType
+
+	return nil
+}
+
+func (m *TransformerType) ToSchema(d *schema.ResourceData) error {
+	d.SetId(m.Name)
+
+	d.Set("sn_mva", m.RatedPower)
+	d.Set("vn_hv_kv", m.VoltageHV)
+	d.Set("vn_lv_kv", m.VoltageLV)
+	d.Set("vk_percent", m.VkPercent)
+	d.Set("vkr_percent", m.VkrPercent)
+	d.Set("pfe_kw", m.PfeKw)
+	d.Set("i0_percent", m.I0Percent)
+	d.Set("shift_degree", m.ShiftDegree)
+	d.Set("vector_group", m.VectorGroup)
+	d.Set("tap_side", m.TapSide)
+	d.Set("tap_neutral", m.TapNeutral)
+	d.Set("tap_min", m.TapMin)
+	d.Set("tap_max", m.TapMax)
+	d.Set("tap_step_percent", m.TapStepPct)
+	d.Set("tap_step_degree", m.TapStepDegree)
+
+	parameters := m.Parameters()
+	d.Set("resistance_pu", parameters.ResistancePu)
+	d.Set("reactance_pu", parameters.ReactancePu)
+	d.Set("conductance_pu", parameters.ConductancePu)
+	d.Set("susceptance_pu", parameters.SusceptancePu)
+	d.Set("resistance", parameters.Resistance)
+	d.Set("reactance", parameters.Reactance)
+	d.Set("conductance", parameters.Conductance)
+	d.Set("susceptance", parameters.Susceptance)
+
+	return nil
+}
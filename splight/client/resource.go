@@ -4,12 +4,23 @@ import (
 	"bytes"
 	"encoding/json"
 	"fmt"
+	"io"
 	"net/http"
+	"net/url"
+	"strings"
 
 	"github.com/splightplatform/terraform-provider-splight/splight/client/models"
 )
 
 func Save[T models.SplightModel](c *Client, m T) error {
+	if collection, ok := any(m).(models.AssetCollection); ok {
+		// The collection exists along with its asset, the items saved so far are kept
+		if err := saveExtras(c, collection.GetExtras(), collection.GetAsset()); err != nil {
+			return &PartialSaveError{Err: err}
+		}
+		return nil
+	}
+
 	url := m.ResourcePath()
 
 	buf := bytes.Buffer{}
@@ -33,6 +44,18 @@ func Save[T models.SplightModel](c *Client, m T) error {
 		return err
 	}
 
+	if extras, ok := any(m).(models.ExtrasProvider); ok {
+		if err := saveExtras(c, extras.GetExtras(), m.GetId()); err != nil {
+			return &PartialSaveError{Err: err}
+		}
+	}
+
+	if kinded, ok := any(m).(models.KindFieldsProvider); ok {
+		if err := saveExtras(c, kinded.GetKindFields(), m.GetId()); err != nil {
+			return &PartialSaveError{Err: err}
+		}
+	}
+
 	if fileModel, ok := any(m).(*models.File); ok {
 		if !fileModel.Uploaded {
 			// TODO: delete model if this fails
@@ -55,6 +78,14 @@ func Save[T models.SplightModel](c *Client, m T) error {
 }
 
 func Retrieve[T models.SplightModel](c *Client, m T, id string) error {
+	if collection, ok := any(m).(models.AssetCollection); ok {
+		// The collection is gone along with its asset
+		if err := Retrieve(c, &models.Asset{}, collection.GetAsset()); err != nil {
+			return err
+		}
+		return retrieveExtras(c, collection.GetExtras())
+	}
+
 	url := fmt.Sprintf("%s%s/", m.ResourcePath(), id)
 
 	body, httpErr := c.HttpRequest(url, http.MethodGet, bytes.Buffer{})
@@ -67,6 +98,18 @@ func Retrieve[T models.SplightModel](c *Client, m T, id string) error {
 		return fmt.Errorf("error decoding model: %w", err)
 	}
 
+	if extras, ok := any(m).(models.ExtrasProvider); ok {
+		if err := retrieveExtras(c, extras.GetExtras()); err != nil {
+			return err
+		}
+	}
+
+	if kinded, ok := any(m).(models.KindFieldsProvider); ok {
+		if err := retrieveExtras(c, kinded.GetKindFields()); err != nil {
+			return err
+		}
+	}
+
 	if fileModel, ok := any(m).(*models.File); ok {
 		httpErr := c.UpdateFileChecksum(fileModel)
 		if httpErr != nil {
@@ -77,18 +120,218 @@ func Retrieve[T models.SplightModel](c *Client, m T, id string) error {
 	return nil
 }
 
+// saveExtras reconciles the extra attributes and metadata of an asset: the
+// ones removed from the configuration are deleted, the rest created or updated
+func saveExtras(c *Client, extras *models.AssetExtras, asset string) error {
+	for _, id := range extras.StaleAttributes {
+		if err := deleteIgnoringNotFound(c, &models.AssetAttribute{}, id); err != nil {
+			return fmt.Errorf("error deleting extra attribute %s: %w", id, err)
+		}
+	}
+	for _, id := range extras.StaleMetadata {
+		if err := deleteIgnoringNotFound(c, &models.AssetMetadata{}, id); err != nil {
+			return fmt.Errorf("error deleting extra metadata %s: %w", id, err)
+		}
+	}
+	extras.StaleAttributes = nil
+	extras.StaleMetadata = nil
+
+	extras.SetAsset(asset)
+
+	for i := range extras.ExtraAttributes {
+		if extras.Unchanged(extras.ExtraAttributes[i].Id) {
+			continue
+		}
+		if err := Save(c, &extras.ExtraAttributes[i]); err != nil {
+			err = fmt.Errorf("error saving extra attribute %s: %w", extras.ExtraAttributes[i].Name, err)
+			keepSaved(extras)
+			return err
+		}
+	}
+	for i := range extras.ExtraMetadata {
+		if extras.Unchanged(extras.ExtraMetadata[i].Id) {
+			continue
+		}
+		if err := Save(c, &extras.ExtraMetadata[i].AssetMetadata); err != nil {
+			err = fmt.Errorf("error saving extra metadata %s: %w", extras.ExtraMetadata[i].Name, err)
+			keepSaved(extras)
+			return err
+		}
+	}
+
+	return nil
+}
+
+// keepSaved drops the extras that weren't created yet, so only the ones saved
+// so far are written to the state and the rest are planned again
+func keepSaved(extras *models.AssetExtras) {
+	attributes := extras.ExtraAttributes[:0]
+	for _, attribute := range extras.ExtraAttributes {
+		if attribute.Id != "" {
+			attributes = append(attributes, attribute)
+		}
+	}
+	extras.ExtraAttributes = attributes
+
+	metadata := extras.ExtraMetadata[:0]
+	for _, item := range extras.ExtraMetadata {
+		if item.Id != "" {
+			metadata = append(metadata, item)
+		}
+	}
+	extras.ExtraMetadata = metadata
+}
+
+// retrieveExtras refreshes the extra attributes and metadata known from the
+// state. The ones deleted outside of Terraform are dropped, so they're created again.
+func retrieveExtras(c *Client, extras *models.AssetExtras) error {
+	attributes := extras.ExtraAttributes[:0]
+	for _, attribute := range extras.ExtraAttributes {
+		if attribute.Id == "" {
+			continue
+		}
+		if err := Retrieve(c, &attribute, attribute.Id); err != nil {
+			if isNotFound(err) {
+				continue
+			}
+			return fmt.Errorf("error reading extra attribute %s: %w", attribute.Name, err)
+		}
+		attributes = append(attributes, attribute)
+	}
+	extras.ExtraAttributes = attributes
+
+	metadata := extras.ExtraMetadata[:0]
+	for _, item := range extras.ExtraMetadata {
+		if item.Id == "" {
+			continue
+		}
+		if err := Retrieve(c, &item.AssetMetadata, item.Id); err != nil {
+			if isNotFound(err) {
+				continue
+			}
+			return fmt.Errorf("error reading extra metadata %s: %w", item.Name, err)
+		}
+		metadata = append(metadata, item)
+	}
+	extras.ExtraMetadata = metadata
+
+	return nil
+}
+
+func isNotFound(err error) bool {
+	httpErr, ok := err.(*HttpError)
+	return ok && httpErr.StatusCode == http.StatusNotFound
+}
+
+func deleteIgnoringNotFound[T models.SplightModel](c *Client, m T, id string) error {
+	if err := Delete(c, m, id); err != nil && !isNotFound(err) {
+		return err
+	}
+	return nil
+}
+
 func List[T models.DataSource](c *Client, m T) error {
-	url := m.ResourcePath()
+	return listPages(c, m.ResourcePath(), m)
+}
+
+// page is a page of a paginated list, along with the path of the next one
+type page struct {
+	Next    *string           `json:"next"`
+	Results []json.RawMessage `json:"results"`
+}
 
-	body, err := c.HttpRequest(url, http.MethodGet, bytes.Buffer{})
+// listPages fetches every page of a list, following their next links, and
+// decodes the results of all of them at once into m. The first page is
+// decoded as it is, so lists that aren't paginated and fields besides the
+// results are kept.
+func listPages(c *Client, path string, m any) error {
+	var results []json.RawMessage
+	visited := map[string]bool{}
+	pages := 0
+
+	for path != "" && !visited[path] {
+		visited[path] = true
+
+		body, err := c.HttpRequest(path, http.MethodGet, bytes.Buffer{})
+		if err != nil {
+			return err
+		}
+		data, readErr := io.ReadAll(body)
+		body.Close()
+		if readErr != nil {
+			return fmt.Errorf("error reading list response: %w", readErr)
+		}
+
+		var current page
+		pageErr := json.Unmarshal(data, &current)
+		if pages == 0 {
+			if err := json.Unmarshal(data, m); err != nil {
+				return fmt.Errorf("error decoding list of %s: %w", path, err)
+			}
+			if pageErr != nil || current.Results == nil {
+				return nil
+			}
+		} else if pageErr != nil {
+			return fmt.Errorf("invalid page of %s: %w", path, pageErr)
+		} else if current.Results == nil {
+			return fmt.Errorf("invalid page of %s: no results", path)
+		}
+		results = append(results, current.Results...)
+		pages++
+
+		path = ""
+		if current.Next != nil {
+			if path, readErr = c.pagePath(*current.Next); readErr != nil {
+				return readErr
+			}
+		}
+	}
+
+	if pages <= 1 {
+		return nil
+	}
+
+	// Only the results are replaced, the rest of the first page is kept
+	encoded, err := json.Marshal(map[string]any{"results": results})
 	if err != nil {
 		return err
 	}
+	return json.Unmarshal(encoded, m)
+}
 
-	return json.NewDecoder(body).Decode(m)
+// pagePath returns the path of the next page of a list, which the API gives
+// as a full URL
+func (c *Client) pagePath(next string) (string, error) {
+	if next == "" {
+		return "", nil
+	}
+	if path, ok := strings.CutPrefix(next, c.hostname+"/"); ok {
+		return path, nil
+	}
+
+	parsed, err := url.Parse(next)
+	if err != nil {
+		return "", fmt.Errorf("invalid next page %q: %w", next, err)
+	}
+	return strings.TrimPrefix(parsed.RequestURI(), "/"), nil
 }
 
 func Delete[T models.SplightModel](c *Client, m T, id string) error {
+	if collection, ok := any(m).(models.AssetCollection); ok {
+		extras := collection.GetExtras()
+		for _, attribute := range extras.ExtraAttributes {
+			if err := deleteIgnoringNotFound(c, &attribute, attribute.Id); err != nil {
+				return err
+			}
+		}
+		for _, item := range extras.ExtraMetadata {
+			if err := deleteIgnoringNotFound(c, &item.AssetMetadata, item.Id); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
 	url := fmt.Sprintf("%s%s/", m.ResourcePath(), id)
 
 	_, err := c.HttpRequest(url, http.MethodDelete, bytes.Buffer{})
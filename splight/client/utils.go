@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"net/http"
+	"net/url"
 	"os"
 	"strconv"
 	"time"
@@ -135,3 +136,180 @@ func (c *Client) UploadFile(model *models.File) error {
 
 	return nil
 }
+
+// RetrieveAssetKind fetches the definition of an asset kind by id, or by
+// name when the id is empty
+func (c *Client) RetrieveAssetKind(id, name string) (*models.AssetKind, error) {
+	if id == "" {
+		kinds := &models.AssetKinds{}
+		if err := List(c, kinds); err != nil {
+			return nil, fmt.Errorf("error listing asset kinds: %w", err)
+		}
+
+		for _, kind := range kinds.Kinds {
+			if kind.Name == name {
+				id = kind.Id
+				break
+			}
+		}
+		if id == "" {
+			return nil, fmt.Errorf("asset kind %q not found", name)
+		}
+	}
+
+	kind := &models.AssetKind{}
+	if err := Retrieve(c, kind, id); err != nil {
+		return nil, err
+	}
+	return kind, nil
+}
+
+// ListAssetChildren fetches the assets placed directly under a parent
+func (c *Client) ListAssetChildren(parent string) ([]models.AssetNode, error) {
+	children := &models.AssetChildren{}
+	path := fmt.Sprintf("%s?%s", children.ResourcePath(), url.Values{"parent": {parent}}.Encode())
+
+	if err := listPages(c, path, children); err != nil {
+		return nil, err
+	}
+
+	var assets []models.AssetNode
+	for _, child := range children.Assets {
+		// The filter may be ignored, the parent is checked again
+		if child.Parent != nil && child.Parent.Id == parent {
+			assets = append(assets, child)
+		}
+	}
+	return assets, nil
+}
+
+// RetrieveAssetSubtree walks the hierarchy under the root of a subtree one
+// level at a time. Assets already found are skipped, so a cycle ends the walk.
+func (c *Client) RetrieveAssetSubtree(subtree *models.AssetSubtree) error {
+	visited := map[string]bool{subtree.Root: true}
+	level := []string{subtree.Root}
+	subtree.Nodes = nil
+
+	for depth := 1; len(level) > 0 && (subtree.MaxDepth == 0 || depth <= subtree.MaxDepth); depth++ {
+		var next []string
+		for _, parent := range level {
+			children, err := c.ListAssetChildren(parent)
+			if err != nil {
+				return fmt.Errorf("error listing assets under %s: %w", parent, err)
+			}
+
+			for _, child := range children {
+				if visited[child.Id] {
+					continue
+				}
+				visited[child.Id] = true
+				subtree.Nodes = append(subtree.Nodes, models.AssetSubtreeNode{AssetNode: child, Depth: depth})
+				next = append(next, child.Id)
+			}
+		}
+		level = next
+	}
+
+	return nil
+}
+
+// ListAssetRelations fetches the relations of an asset, the ones starting at
+// it when field is "asset" or the ones ending at it when it's "related_asset"
+func (c *Client) ListAssetRelations(field, asset string) ([]models.AssetRelation, error) {
+	relations := &models.AssetRelations{}
+	path := fmt.Sprintf("%s?%s", relations.ResourcePath(), url.Values{field: {asset}}.Encode())
+
+	if err := listPages(c, path, relations); err != nil {
+		return nil, err
+	}
+
+	var matching []models.AssetRelation
+	for _, relation := range relations.Relations {
+		// The filter may be ignored, the end of the relation is checked again
+		var end string
+		switch field {
+		case "asset":
+			end = relation.Asset.Id
+		case "related_asset":
+			if relation.RelatedAsset != nil {
+				end = relation.RelatedAsset.Id
+			}
+		}
+		if end == asset {
+			matching = append(matching, relation)
+		}
+	}
+	return matching, nil
+}
+
+// RetrieveAssetRelationGraph walks the relations from the root of a graph
+// one step at a time. Each relation is only added once and each asset only
+// walked once, so cycles end the walk.
+func (c *Client) RetrieveAssetRelationGraph(graph *models.AssetRelationGraph) error {
+	visitedAssets := map[string]bool{graph.Root: true}
+	visitedRelations := map[string]bool{}
+	level := []string{graph.Root}
+	graph.Edges = nil
+
+	for depth := 1; len(level) > 0 && (graph.MaxDepth == 0 || depth <= graph.MaxDepth); depth++ {
+		var next []string
+		for _, asset := range level {
+			var relations []models.AssetRelation
+			if graph.Follows("outgoing") {
+				outgoing, err := c.ListAssetRelations("asset", asset)
+				if err != nil {
+					return fmt.Errorf("error listing relations of %s: %w", asset, err)
+				}
+				relations = append(relations, outgoing...)
+			}
+			if graph.Follows("incoming") {
+				incoming, err := c.ListAssetRelations("related_asset", asset)
+				if err != nil {
+					return fmt.Errorf("error listing relations to %s: %w", asset, err)
+				}
+				relations = append(relations, incoming...)
+			}
+
+			for _, relation := range relations {
+				if visitedRelations[relation.Id] {
+					continue
+				}
+				visitedRelations[relation.Id] = true
+				graph.Edges = append(graph.Edges, models.AssetRelationEdge{AssetRelation: relation, Depth: depth})
+
+				// The other end of the relation is walked next
+				other := relation.Asset.Id
+				if other == asset && relation.RelatedAsset != nil {
+					other = relation.RelatedAsset.Id
+				}
+				if other != "" && !visitedAssets[other] {
+					visitedAssets[other] = true
+					next = append(next, other)
+				}
+			}
+		}
+		level = next
+	}
+
+	return nil
+}
+
+// ListAttributeAssets fetches the ids of the assets that have an attribute
+// with the given name
+func (c *Client) ListAttributeAssets(name string) (map[string]bool, error) {
+	attributes := &models.AssetAttributeList{}
+	path := fmt.Sprintf("%s?%s", attributes.ResourcePath(), url.Values{"name": {name}}.Encode())
+
+	if err := listPages(c, path, attributes); err != nil {
+		return nil, err
+	}
+
+	assets := map[string]bool{}
+	for _, attribute := range attributes.Attributes {
+		// The filter may not be exact, the name is checked again
+		if attribute.Name == name {
+			assets[attribute.Asset] = true
+		}
+	}
+	return assets, nil
+}
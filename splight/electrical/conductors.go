@@ -0,0 +1,84 @@
+package electrical
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+)
+
+// ConductorType holds the catalog properties of a standard bare conductor
+type ConductorType struct {
+	Stranding                  string  // aluminum/steel strands, or aluminum strands only
+	Diameter                   float64 // m
+	ReferenceResistance        float64 // DC resistance in Ω/km at ResistanceReferenceTemperature
+	ConductorMass              float64 // kg/m
+	SpecificHeat               float64 // J/(kg·°C), mass weighted for composite conductors
+	ThermalElongationCoef      float64 // 1/°C
+	TemperatureCoeffResistance float64 // 1/°C
+}
+
+// ConductorUnits are the units of each ConductorType property, keyed by the
+// name of the matching line metadata
+var ConductorUnits = map[string]string{
+	"diameter":                     "m",
+	"reference_resistance":         "Ω/km",
+	"conductor_mass":               "kg/m",
+	"specific_heat":                "J/(kg·°C)",
+	"thermal_elongation_coef":      "1/°C",
+	"temperature_coeff_resistance": "1/°C",
+}
+
+// conductorTypes is the embedded conductor catalog. ACSR values follow the
+// ASTM B232 code words and AAAC values the ASTM B399 (6201-T81) ones.
+var conductorTypes = map[string]ConductorType{
+	"ACSR Partridge": {Stranding: "26/7", Diameter: 0.01631, ReferenceResistance: 0.2090, ConductorMass: 0.5462, SpecificHeat: 766, ThermalElongationCoef: 18.9e-6, TemperatureCoeffResistance: 0.00403},
+	"ACSR Linnet":    {Stranding: "26/7", Diameter: 0.01831, ReferenceResistance: 0.1657, ConductorMass: 0.6875, SpecificHeat: 766, ThermalElongationCoef: 18.9e-6, TemperatureCoeffResistance: 0.00403},
+	"ACSR Hawk":      {Stranding: "26/7", Diameter: 0.02179, ReferenceResistance: 0.1168, ConductorMass: 0.9747, SpecificHeat: 766, ThermalElongationCoef: 18.9e-6, TemperatureCoeffResistance: 0.00403},
+	"ACSR Dove":      {Stranding: "26/7", Diameter: 0.02355, ReferenceResistance: 0.1001, ConductorMass: 1.1384, SpecificHeat: 766, ThermalElongationCoef: 18.9e-6, TemperatureCoeffResistance: 0.00403},
+	"ACSR Grosbeak":  {Stranding: "26/7", Diameter: 0.02515, ReferenceResistance: 0.0876, ConductorMass: 1.3007, SpecificHeat: 766, ThermalElongationCoef: 18.9e-6, TemperatureCoeffResistance: 0.00403},
+	"ACSR Drake":     {Stranding: "26/7", Diameter: 0.02814, ReferenceResistance: 0.0702, ConductorMass: 1.6266, SpecificHeat: 766, ThermalElongationCoef: 18.9e-6, TemperatureCoeffResistance: 0.00403},
+	"ACSR Rail":      {Stranding: "45/7", Diameter: 0.02959, ReferenceResistance: 0.0587, ConductorMass: 1.5998, SpecificHeat: 822, ThermalElongationCoef: 20.9e-6, TemperatureCoeffResistance: 0.00403},
+	"ACSR Cardinal":  {Stranding: "54/7", Diameter: 0.03038, ReferenceResistance: 0.0581, ConductorMass: 1.8290, SpecificHeat: 760, ThermalElongationCoef: 19.3e-6, TemperatureCoeffResistance: 0.00403},
+	"ACSR Bluejay":   {Stranding: "45/7", Diameter: 0.03198, ReferenceResistance: 0.0505, ConductorMass: 1.8676, SpecificHeat: 822, ThermalElongationCoef: 20.9e-6, TemperatureCoeffResistance: 0.00403},
+	"ACSR Falcon":    {Stranding: "54/19", Diameter: 0.03924, ReferenceResistance: 0.0354, ConductorMass: 3.0418, SpecificHeat: 760, ThermalElongationCoef: 19.4e-6, TemperatureCoeffResistance: 0.00403},
+	"AAAC Butte":     {Stranding: "19", Diameter: 0.01630, ReferenceResistance: 0.2072, ConductorMass: 0.4349, SpecificHeat: 897, ThermalElongationCoef: 23e-6, TemperatureCoeffResistance: 0.00347},
+	"AAAC Canton":    {Stranding: "19", Diameter: 0.01830, ReferenceResistance: 0.1643, ConductorMass: 0.5485, SpecificHeat: 897, ThermalElongationCoef: 23e-6, TemperatureCoeffResistance: 0.00347},
+	"AAAC Darien":    {Stranding: "19", Diameter: 0.02179, ReferenceResistance: 0.1158, ConductorMass: 0.7779, SpecificHeat: 897, ThermalElongationCoef: 23e-6, TemperatureCoeffResistance: 0.00347},
+	"AAAC Elgin":     {Stranding: "19", Diameter: 0.02353, ReferenceResistance: 0.0993, ConductorMass: 0.9070, SpecificHeat: 897, ThermalElongationCoef: 23e-6, TemperatureCoeffResistance: 0.00347},
+	"AAAC Flint":     {Stranding: "37", Diameter: 0.02516, ReferenceResistance: 0.0875, ConductorMass: 1.0299, SpecificHeat: 897, ThermalElongationCoef: 23e-6, TemperatureCoeffResistance: 0.00347},
+	"AAAC Greeley":   {Stranding: "37", Diameter: 0.02815, ReferenceResistance: 0.0699, ConductorMass: 1.2891, SpecificHeat: 897, ThermalElongationCoef: 23e-6, TemperatureCoeffResistance: 0.00347},
+}
+
+// ConductorTypeNames returns the names in the conductor catalog, sorted
+func ConductorTypeNames() []string {
+	names := make([]string, 0, len(conductorTypes))
+	for name := range conductorTypes {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+	return names
+}
+
+// LookupConductorType returns a conductor from the catalog by name
+func LookupConductorType(name string) (ConductorType, error) {
+	conductorType, ok := conductorTypes[name]
+	if !ok {
+		return ConductorType{}, fmt.Errorf(
+			"unknown conductor type %q, expected one of: %s",
+			name, strings.Join(ConductorTypeNames(), ", "),
+		)
+	}
+	return conductorType, nil
+}
+
+// Metadata returns the conductor properties keyed by line metadata name
+func (c ConductorType) Metadata() map[string]float64 {
+	return map[string]float64{
+		"diameter":                     c.Diameter,
+		"reference_resistance":         c.ReferenceResistance,
+		"conductor_mass":               c.ConductorMass,
+		"specific_heat":                c.SpecificHeat,
+		"thermal_elongation_coef":      c.ThermalElongationCoef,
+		"temperature_coeff_resistance": c.TemperatureCoeffResistance,
+	}
+}
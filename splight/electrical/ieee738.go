@@ -0,0 +1,201 @@
+package electrical
+
+import (
+	"fmt"
+	"math"
+)
+
+// ResistanceReferenceTemperature is the temperature in °C at which conductor
+// resistances are given (reference_resistance metadata).
+const ResistanceReferenceTemperature = 20.0
+
+// Conductor holds the line metadata needed for a thermal rating
+type Conductor struct {
+	Diameter                   float64 // m
+	ReferenceResistance        float64 // Ω/km at ResistanceReferenceTemperature
+	TemperatureCoeffResistance float64 // 1/°C
+	Absorptivity               float64 // solar absorptivity, between 0 and 1
+	Emissivity                 float64 // emissivity, between 0 and 1
+	MaximumAllowedTemperature  float64 // °C
+}
+
+// WeatherConditions are the assumed ambient conditions around a line segment
+type WeatherConditions struct {
+	AmbientTemperature float64 // °C
+	WindSpeed          float64 // m/s
+	WindDirection      float64 // degrees, clockwise from true north (direction the wind blows from)
+	Altitude           float64 // m above sea level
+	Azimuth            float64 // line azimuth in degrees, clockwise from true north
+	Latitude           float64 // degrees
+	DayOfYear          int     // 1 to 366
+	SolarHour          float64 // local solar time, 0 to 24 (12 is solar noon)
+	ClearAtmosphere    bool    // clear (true) or industrial (false) atmosphere
+}
+
+// ResistanceAt returns the AC resistance in Ω/m at the given temperature in °C,
+// interpolated linearly from the reference resistance.
+func (c Conductor) ResistanceAt(temperature float64) float64 {
+	perKm := c.ReferenceResistance * (1 + c.TemperatureCoeffResistance*(temperature-ResistanceReferenceTemperature))
+	return perKm / 1000
+}
+
+func (c Conductor) validate() error {
+	if c.Diameter <= 0 {
+		return fmt.Errorf("diameter must be greater than zero")
+	}
+	if c.ReferenceResistance <= 0 {
+		return fmt.Errorf("reference_resistance must be greater than zero")
+	}
+	if c.Absorptivity < 0 || c.Absorptivity > 1 {
+		return fmt.Errorf("absorptivity must be between 0 and 1")
+	}
+	if c.Emissivity < 0 || c.Emissivity > 1 {
+		return fmt.Errorf("emissivity must be between 0 and 1")
+	}
+	return nil
+}
+
+func (w WeatherConditions) validate() error {
+	if w.WindSpeed < 0 {
+		return fmt.Errorf("wind_speed must not be negative")
+	}
+	if w.Latitude < -90 || w.Latitude > 90 {
+		return fmt.Errorf("latitude must be between -90 and 90")
+	}
+	if w.DayOfYear < 1 || w.DayOfYear > 366 {
+		return fmt.Errorf("day_of_year must be between 1 and 366")
+	}
+	if w.SolarHour < 0 || w.SolarHour > 24 {
+		return fmt.Errorf("solar_hour must be between 0 and 24")
+	}
+	return nil
+}
+
+// SteadyStateAmpacity computes the steady-state thermal rating in amperes of a
+// bare overhead conductor following IEEE Std 738-2012. It is the current that
+// keeps the conductor at its maximum allowed temperature under the given weather.
+func SteadyStateAmpacity(conductor Conductor, weather WeatherConditions) (float64, error) {
+	if err := conductor.validate(); err != nil {
+		return 0, err
+	}
+	if err := weather.validate(); err != nil {
+		return 0, err
+	}
+
+	conductorTemperature := conductor.MaximumAllowedTemperature
+	if conductorTemperature <= weather.AmbientTemperature {
+		return 0, fmt.Errorf("maximum_allowed_temperature must be greater than the ambient temperature")
+	}
+
+	convection := convectionHeatLoss(conductor, weather, conductorTemperature)
+	radiation := radiatedHeatLoss(conductor, weather, conductorTemperature)
+	solar := solarHeatGain(conductor, weather)
+
+	// Heat balance: q_c + q_r = q_s + I² R(T_c)
+	net := convection + radiation - solar
+	if net <= 0 {
+		return 0, nil
+	}
+
+	return math.Sqrt(net / conductor.ResistanceAt(conductorTemperature)), nil
+}
+
+// convectionHeatLoss returns the convective heat loss in W/m (IEEE 738-2012, 4.4.3)
+func convectionHeatLoss(conductor Conductor, weather WeatherConditions, conductorTemperature float64) float64 {
+	diameter := conductor.Diameter
+	deltaT := conductorTemperature - weather.AmbientTemperature
+	filmTemperature := (conductorTemperature + weather.AmbientTemperature) / 2
+	elevation := weather.Altitude
+
+	airViscosity := 1.458e-6 * math.Pow(filmTemperature+273, 1.5) / (filmTemperature + 383.4)
+	airDensity := (1.293 - 1.525e-4*elevation + 6.379e-9*elevation*elevation) / (1 + 0.00367*filmTemperature)
+	airConductivity := 2.424e-2 + 7.477e-5*filmTemperature - 4.407e-9*filmTemperature*filmTemperature
+
+	// Natural convection applies even when there is no wind
+	natural := 3.645 * math.Sqrt(airDensity) * math.Pow(diameter, 0.75) * math.Pow(deltaT, 1.25)
+	if weather.WindSpeed == 0 {
+		return natural
+	}
+
+	reynolds := diameter * airDensity * weather.WindSpeed / airViscosity
+
+	// Angle between the wind and the conductor axis, folded into 0 to 90°
+	// since wind from either side or end of the conductor cools it the same
+	angle := math.Mod(math.Abs(weather.WindDirection-weather.Azimuth), 180)
+	if angle > 90 {
+		angle = 180 - angle
+	}
+	phi := toRadians(angle)
+	windDirectionFactor := 1.194 - math.Cos(phi) + 0.194*math.Cos(2*phi) + 0.368*math.Sin(2*phi)
+
+	lowWind := windDirectionFactor * (1.01 + 1.35*math.Pow(reynolds, 0.52)) * airConductivity * deltaT
+	highWind := windDirectionFactor * 0.754 * math.Pow(reynolds, 0.6) * airConductivity * deltaT
+
+	return math.Max(natural, math.Max(lowWind, highWind))
+}
+
+// radiatedHeatLoss returns the radiated heat loss in W/m (IEEE 738-2012, 4.4.4)
+func radiatedHeatLoss(conductor Conductor, weather WeatherConditions, conductorTemperature float64) float64 {
+	return 17.8 * conductor.Diameter * conductor.Emissivity *
+		(math.Pow((conductorTemperature+273)/100, 4) - math.Pow((weather.AmbientTemperature+273)/100, 4))
+}
+
+// solarHeatGain returns the solar heat gain in W/m (IEEE 738-2012, 4.4.5)
+func solarHeatGain(conductor Conductor, weather WeatherConditions) float64 {
+	latitude := toRadians(weather.Latitude)
+	declination := toRadians(23.46 * math.Sin(toRadians((284+float64(weather.DayOfYear))/365*360)))
+	hourAngle := toRadians((weather.SolarHour - 12) * 15)
+
+	sinAltitude := math.Cos(latitude)*math.Cos(declination)*math.Cos(hourAngle) + math.Sin(latitude)*math.Sin(declination)
+	solarAltitude := math.Asin(sinAltitude)
+	if solarAltitude <= 0 {
+		// The sun is below the horizon
+		return 0
+	}
+
+	chi := math.Sin(hourAngle) / (math.Sin(latitude)*math.Cos(hourAngle) - math.Cos(latitude)*math.Tan(declination))
+	solarAzimuthConstant := 0.0
+	switch {
+	case hourAngle < 0 && chi >= 0:
+		solarAzimuthConstant = 0
+	case hourAngle < 0 && chi < 0, hourAngle >= 0 && chi >= 0:
+		solarAzimuthConstant = 180
+	default:
+		solarAzimuthConstant = 360
+	}
+	solarAzimuth := toRadians(solarAzimuthConstant + toDegrees(math.Atan(chi)))
+
+	// Total heat flux density at sea level, polynomial in the solar altitude in degrees
+	coefficients := industrialAtmosphereCoefficients
+	if weather.ClearAtmosphere {
+		coefficients = clearAtmosphereCoefficients
+	}
+	hc := toDegrees(solarAltitude)
+	heatFlux := 0.0
+	for i, coefficient := range coefficients {
+		heatFlux += coefficient * math.Pow(hc, float64(i))
+	}
+
+	elevationFactor := 1 + 1.148e-4*weather.Altitude - 1.108e-8*weather.Altitude*weather.Altitude
+	incidence := math.Acos(math.Cos(solarAltitude) * math.Cos(solarAzimuth-toRadians(weather.Azimuth)))
+
+	return conductor.Absorptivity * heatFlux * elevationFactor * math.Sin(incidence) * conductor.Diameter
+}
+
+// Total heat flux density coefficients (IEEE 738-2012, Table 3)
+var (
+	clearAtmosphereCoefficients = []float64{
+		-42.2391, 63.8044, -1.9220, 3.46921e-2, -3.61118e-4, 1.94318e-6, -4.07608e-9,
+	}
+	industrialAtmosphereCoefficients = []float64{
+		53.1821, 14.2110, 6.6138e-1, -3.1658e-2, 5.4654e-4, -4.3446e-6, 1.3236e-8,
+	}
+)
+
+func toRadians(deg float64) float64 {
+	return deg * math.Pi / 180
+}
+
+func toDegrees(rad float64) float64 {
+	return rad * 180 / math.Pi
+}
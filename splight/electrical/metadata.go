@@ -0,0 +1,39 @@
+package electrical
+
+// MetadataUnits are the units the platform expects for the numeric metadata of
+// typed assets, keyed by metadata name. Impedances and admittances of lines
+// and transformers are totals, not per unit length.
+var MetadataUnits = map[string]string{
+	"length":                          "km",
+	"diameter":                        "m",
+	"reference_resistance":            "Ω/km",
+	"conductor_mass":                  "kg/m",
+	"specific_heat":                   "J/(kg·°C)",
+	"thermal_elongation_coef":         "1/°C",
+	"temperature_coeff_resistance":    "1/°C",
+	"maximum_allowed_temperature":     "°C",
+	"maximum_allowed_temperature_lte": "°C",
+	"maximum_allowed_temperature_ste": "°C",
+	"maximum_allowed_current":         "A",
+	"maximum_allowed_power":           "MVA",
+	"resistance":                      "Ω",
+	"reactance":                       "Ω",
+	"conductance":                     "S",
+	"susceptance":                     "S",
+	"capacitance":                     "F",
+	"xn_ohm":                          "Ω",
+	"nominal_voltage_kv":              "kV",
+	"altitude":                        "m",
+	"azimuth":                         "°",
+	"span_length":                     "m",
+	"cumulative_distance":             "m",
+	"reference_sag":                   "m",
+	"reference_temperature":           "°C",
+	"rated_energy":                    "MWh",
+	"rated_power":                     "MW",
+	"round_trip_efficiency":           "%",
+	"min_soc":                         "%",
+	"max_soc":                         "%",
+	"rated_active_power":              "MW",
+	"rated_reactive_power":            "Mvar",
+}
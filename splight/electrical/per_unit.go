@@ -0,0 +1,91 @@
+package electrical
+
+import (
+	"fmt"
+	"math"
+	"slices"
+	"strings"
+)
+
+// Quantities that can be expressed in per-unit, with the unit of their
+// ohmic value. Resistance and reactance share the impedance base, while
+// conductance and susceptance share the admittance base.
+var perUnitQuantities = map[string]string{
+	"impedance":   "Ω",
+	"resistance":  "Ω",
+	"reactance":   "Ω",
+	"admittance":  "S",
+	"conductance": "S",
+	"susceptance": "S",
+	"voltage":     "kV",
+	"power":       "MVA",
+	"current":     "A",
+}
+
+// PerUnitQuantities returns the quantities accepted by BaseValue, sorted
+func PerUnitQuantities() []string {
+	quantities := make([]string, 0, len(perUnitQuantities))
+	for quantity := range perUnitQuantities {
+		quantities = append(quantities, quantity)
+	}
+	slices.Sort(quantities)
+	return quantities
+}
+
+// BaseValue returns the base of a quantity for a three-phase system with the
+// given line-to-line base voltage in kV and base power in MVA. The base is
+// expressed in the unit listed in perUnitQuantities.
+func BaseValue(quantity string, baseKV, baseMVA float64) (float64, error) {
+	if _, ok := perUnitQuantities[quantity]; !ok {
+		return 0, fmt.Errorf(
+			"unknown quantity %q, expected one of: %s",
+			quantity, strings.Join(PerUnitQuantities(), ", "),
+		)
+	}
+	if baseKV <= 0 {
+		return 0, fmt.Errorf("base_kv must be greater than zero")
+	}
+	if baseMVA <= 0 {
+		return 0, fmt.Errorf("base_mva must be greater than zero")
+	}
+
+	switch quantity {
+	case "impedance", "resistance", "reactance":
+		return baseKV * baseKV / baseMVA, nil
+	case "admittance", "conductance", "susceptance":
+		return baseMVA / (baseKV * baseKV), nil
+	case "voltage":
+		return baseKV, nil
+	case "power":
+		return baseMVA, nil
+	default:
+		// current
+		return baseMVA * 1000 / (math.Sqrt(3) * baseKV), nil
+	}
+}
+
+// ToPerUnit converts an ohmic value to per-unit on the given base
+func ToPerUnit(value float64, quantity string, baseKV, baseMVA float64) (float64, error) {
+	base, err := BaseValue(quantity, baseKV, baseMVA)
+	if err != nil {
+		return 0, err
+	}
+	return value / base, nil
+}
+
+// FromPerUnit converts a per-unit value on the given base to its ohmic value
+func FromPerUnit(value float64, quantity string, baseKV, baseMVA float64) (float64, error) {
+	base, err := BaseValue(quantity, baseKV, baseMVA)
+	if err != nil {
+		return 0, err
+	}
+	return value * base, nil
+}
+
+// PerKm returns the value per km of a total value over a length in km
+func PerKm(value, length float64) (float64, error) {
+	if length <= 0 {
+		return 0, fmt.Errorf("length must be greater than zero")
+	}
+	return value / length, nil
+}
@@ -0,0 +1,128 @@
+package electrical
+
+import (
+	"fmt"
+	"math"
+	"slices"
+	"strings"
+)
+
+// TransformerType holds the nameplate data of a two-winding transformer,
+// with the same fields as pandapower's trafo std_types
+type TransformerType struct {
+	RatedPower    float64 // sn_mva, MVA
+	VoltageHV     float64 // vn_hv_kv, kV
+	VoltageLV     float64 // vn_lv_kv, kV
+	VkPercent     float64 // short-circuit voltage, % of rated voltage
+	VkrPercent    float64 // real part of the short-circuit voltage, %
+	PfeKw         float64 // iron losses, kW
+	I0Percent     float64 // open-circuit current, % of rated current
+	ShiftDegree   float64 // phase shift, degrees
+	VectorGroup   string
+	TapSide       string
+	TapNeutral    int
+	TapMin        int
+	TapMax        int
+	TapStepPct    float64
+	TapStepDegree float64
+}
+
+// TransformerParameters are the equivalent circuit parameters of a transformer,
+// in per-unit on the rated power and the HV voltage, and in ohmic values
+// referred to the HV side
+type TransformerParameters struct {
+	ResistancePu  float64
+	ReactancePu   float64
+	ConductancePu float64
+	SusceptancePu float64
+	Resistance    float64 // Ω
+	Reactance     float64 // Ω
+	Conductance   float64 // S
+	Susceptance   float64 // S
+}
+
+// TransformerUnits are the units of the metadata derived from a transformer type
+var TransformerUnits = map[string]string{
+	"resistance":            "Ω",
+	"reactance":             "Ω",
+	"conductance":           "S",
+	"maximum_allowed_power": "MVA",
+}
+
+// transformerTypes mirrors the pandapower two-winding transformer std_types
+var transformerTypes = map[string]TransformerType{
+	"160 MVA 380/110 kV": {RatedPower: 160, VoltageHV: 380, VoltageLV: 110, VkPercent: 12.2, VkrPercent: 0.25, PfeKw: 60, I0Percent: 0.06, ShiftDegree: 0, VectorGroup: "Yy0", TapSide: "hv", TapNeutral: 0, TapMin: -9, TapMax: 9, TapStepPct: 1.5},
+	"100 MVA 220/110 kV": {RatedPower: 100, VoltageHV: 220, VoltageLV: 110, VkPercent: 12, VkrPercent: 0.26, PfeKw: 55, I0Percent: 0.06, ShiftDegree: 0, VectorGroup: "Yy0", TapSide: "hv", TapNeutral: 0, TapMin: -9, TapMax: 9, TapStepPct: 1.5},
+	"63 MVA 110/20 kV":   {RatedPower: 63, VoltageHV: 110, VoltageLV: 20, VkPercent: 18, VkrPercent: 0.32, PfeKw: 22, I0Percent: 0.04, ShiftDegree: 150, VectorGroup: "YNd5", TapSide: "hv", TapNeutral: 0, TapMin: -9, TapMax: 9, TapStepPct: 1.5},
+	"40 MVA 110/20 kV":   {RatedPower: 40, VoltageHV: 110, VoltageLV: 20, VkPercent: 16.2, VkrPercent: 0.34, PfeKw: 18, I0Percent: 0.05, ShiftDegree: 150, VectorGroup: "YNd5", TapSide: "hv", TapNeutral: 0, TapMin: -9, TapMax: 9, TapStepPct: 1.5},
+	"25 MVA 110/20 kV":   {RatedPower: 25, VoltageHV: 110, VoltageLV: 20, VkPercent: 12, VkrPercent: 0.41, PfeKw: 14, I0Percent: 0.07, ShiftDegree: 150, VectorGroup: "YNd5", TapSide: "hv", TapNeutral: 0, TapMin: -9, TapMax: 9, TapStepPct: 1.5},
+	"63 MVA 110/10 kV":   {RatedPower: 63, VoltageHV: 110, VoltageLV: 10, VkPercent: 18, VkrPercent: 0.32, PfeKw: 22, I0Percent: 0.04, ShiftDegree: 150, VectorGroup: "YNd5", TapSide: "hv", TapNeutral: 0, TapMin: -9, TapMax: 9, TapStepPct: 1.5},
+	"40 MVA 110/10 kV":   {RatedPower: 40, VoltageHV: 110, VoltageLV: 10, VkPercent: 16.2, VkrPercent: 0.34, PfeKw: 18, I0Percent: 0.05, ShiftDegree: 150, VectorGroup: "YNd5", TapSide: "hv", TapNeutral: 0, TapMin: -9, TapMax: 9, TapStepPct: 1.5},
+	"25 MVA 110/10 kV":   {RatedPower: 25, VoltageHV: 110, VoltageLV: 10, VkPercent: 12, VkrPercent: 0.41, PfeKw: 14, I0Percent: 0.07, ShiftDegree: 150, VectorGroup: "YNd5", TapSide: "hv", TapNeutral: 0, TapMin: -9, TapMax: 9, TapStepPct: 1.5},
+	"0.25 MVA 20/0.4 kV": {RatedPower: 0.25, VoltageHV: 20, VoltageLV: 0.4, VkPercent: 6, VkrPercent: 1.44, PfeKw: 0.8, I0Percent: 0.32, ShiftDegree: 150, VectorGroup: "Yzn5", TapSide: "hv", TapNeutral: 0, TapMin: -2, TapMax: 2, TapStepPct: 2.5},
+	"0.4 MVA 20/0.4 kV":  {RatedPower: 0.4, VoltageHV: 20, VoltageLV: 0.4, VkPercent: 6, VkrPercent: 1.425, PfeKw: 1.35, I0Percent: 0.3375, ShiftDegree: 150, VectorGroup: "Dyn5", TapSide: "hv", TapNeutral: 0, TapMin: -2, TapMax: 2, TapStepPct: 2.5},
+	"0.63 MVA 20/0.4 kV": {RatedPower: 0.63, VoltageHV: 20, VoltageLV: 0.4, VkPercent: 6, VkrPercent: 1.206, PfeKw: 1.65, I0Percent: 0.2619, ShiftDegree: 150, VectorGroup: "Dyn5", TapSide: "hv", TapNeutral: 0, TapMin: -2, TapMax: 2, TapStepPct: 2.5},
+	"0.25 MVA 10/0.4 kV": {RatedPower: 0.25, VoltageHV: 10, VoltageLV: 0.4, VkPercent: 4, VkrPercent: 1.2, PfeKw: 0.6, I0Percent: 0.24, ShiftDegree: 150, VectorGroup: "Dyn5", TapSide: "hv", TapNeutral: 0, TapMin: -2, TapMax: 2, TapStepPct: 2.5},
+	"0.4 MVA 10/0.4 kV":  {RatedPower: 0.4, VoltageHV: 10, VoltageLV: 0.4, VkPercent: 4, VkrPercent: 1.325, PfeKw: 0.95, I0Percent: 0.2375, ShiftDegree: 150, VectorGroup: "Dyn5", TapSide: "hv", TapNeutral: 0, TapMin: -2, TapMax: 2, TapStepPct: 2.5},
+	"0.63 MVA 10/0.4 kV": {RatedPower: 0.63, VoltageHV: 10, VoltageLV: 0.4, VkPercent: 4, VkrPercent: 1.0794, PfeKw: 1.18, I0Percent: 0.1873, ShiftDegree: 150, VectorGroup: "Dyn5", TapSide: "hv", TapNeutral: 0, TapMin: -2, TapMax: 2, TapStepPct: 2.5},
+}
+
+// TransformerTypeNames returns the names in the transformer catalog, sorted
+func TransformerTypeNames() []string {
+	names := make([]string, 0, len(transformerTypes))
+	for name := range transformerTypes {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+	return names
+}
+
+// LookupTransformerType returns a transformer from the catalog by name
+func LookupTransformerType(name string) (TransformerType, error) {
+	transformerType, ok := transformerTypes[name]
+	if !ok {
+		return TransformerType{}, fmt.Errorf(
+			"unknown transformer type %q, expected one of: %s",
+			name, strings.Join(TransformerTypeNames(), ", "),
+		)
+	}
+	return transformerType, nil
+}
+
+// Parameters derives the equivalent circuit of the transformer from its
+// short-circuit and open-circuit test data, as pandapower does
+func (t TransformerType) Parameters() TransformerParameters {
+	impedanceBase := t.VoltageHV * t.VoltageHV / t.RatedPower
+
+	impedancePu := t.VkPercent / 100
+	resistancePu := t.VkrPercent / 100
+	reactancePu := math.Sqrt(math.Max(impedancePu*impedancePu-resistancePu*resistancePu, 0))
+
+	admittancePu := t.I0Percent / 100
+	conductancePu := t.PfeKw / (t.RatedPower * 1000)
+	susceptancePu := math.Sqrt(math.Max(admittancePu*admittancePu-conductancePu*conductancePu, 0))
+
+	return TransformerParameters{
+		ResistancePu:  resistancePu,
+		ReactancePu:   reactancePu,
+		ConductancePu: conductancePu,
+		SusceptancePu: susceptancePu,
+		Resistance:    resistancePu * impedanceBase,
+		Reactance:     reactancePu * impedanceBase,
+		Conductance:   conductancePu / impedanceBase,
+		Susceptance:   susceptancePu / impedanceBase,
+	}
+}
+
+// Metadata returns the transformer metadata derived from the type, keyed by
+// metadata name. Standard types don't define a capacitive shunt, so the
+// capacitance is left as it is.
+func (t TransformerType) Metadata() map[string]float64 {
+	parameters := t.Parameters()
+	return map[string]float64{
+		"resistance":            parameters.Resistance,
+		"reactance":             parameters.Reactance,
+		"conductance":           parameters.Conductance,
+		"maximum_allowed_power": t.RatedPower,
+	}
+}
@@ -0,0 +1,62 @@
+package electrical
+
+import (
+	"math"
+	"testing"
+)
+
+// pandapowerTransformerTypes are the values published in pandapower's trafo
+// std_types, used to check the catalog rows against the source they mirror
+var pandapowerTransformerTypes = map[string]struct {
+	snMva, vkPercent, vkrPercent, pfeKw, i0Percent float64
+}{
+	"160 MVA 380/110 kV": {160, 12.2, 0.25, 60, 0.06},
+	"100 MVA 220/110 kV": {100, 12, 0.26, 55, 0.06},
+	"63 MVA 110/20 kV":   {63, 18, 0.32, 22, 0.04},
+	"40 MVA 110/20 kV":   {40, 16.2, 0.34, 18, 0.05},
+	"25 MVA 110/20 kV":   {25, 12, 0.41, 14, 0.07},
+	"63 MVA 110/10 kV":   {63, 18, 0.32, 22, 0.04},
+	"40 MVA 110/10 kV":   {40, 16.2, 0.34, 18, 0.05},
+	"25 MVA 110/10 kV":   {25, 12, 0.41, 14, 0.07},
+	"0.25 MVA 20/0.4 kV": {0.25, 6, 1.44, 0.8, 0.32},
+	"0.4 MVA 20/0.4 kV":  {0.4, 6, 1.425, 1.35, 0.3375},
+	"0.63 MVA 20/0.4 kV": {0.63, 6, 1.206, 1.65, 0.2619},
+	"0.25 MVA 10/0.4 kV": {0.25, 4, 1.2, 0.6, 0.24},
+	"0.4 MVA 10/0.4 kV":  {0.4, 4, 1.325, 0.95, 0.2375},
+	"0.63 MVA 10/0.4 kV": {0.63, 4, 1.0794, 1.18, 0.1873},
+}
+
+func TestTransformerTypesMatchPandapower(t *testing.T) {
+	if len(transformerTypes) != len(pandapowerTransformerTypes) {
+		t.Fatalf("catalog has %d types, pandapower table has %d", len(transformerTypes), len(pandapowerTransformerTypes))
+	}
+
+	for name, published := range pandapowerTransformerTypes {
+		transformerType, err := LookupTransformerType(name)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if transformerType.RatedPower != published.snMva ||
+			transformerType.VkPercent != published.vkPercent ||
+			transformerType.VkrPercent != published.vkrPercent ||
+			transformerType.PfeKw != published.pfeKw ||
+			transformerType.I0Percent != published.i0Percent {
+			t.Errorf("%s: catalog row %+v differs from pandapower %+v", name, transformerType, published)
+		}
+
+		// The open-circuit admittance is recomputed from the published i0 and
+		// must cover the published iron losses, up to pandapower's rounding of i0
+		admittancePu := published.i0Percent / 100
+		conductancePu := published.pfeKw / (published.snMva * 1000)
+		if conductancePu > admittancePu*(1+1e-4) {
+			t.Errorf("%s: iron losses exceed the open-circuit current", name)
+		}
+
+		parameters := transformerType.Parameters()
+		susceptancePu := math.Sqrt(math.Max(admittancePu*admittancePu-conductancePu*conductancePu, 0))
+		if math.Abs(parameters.SusceptancePu-susceptancePu) > 1e-12 {
+			t.Errorf("%s: susceptance %g pu, want %g pu", name, parameters.SusceptancePu, susceptancePu)
+		}
+	}
+}
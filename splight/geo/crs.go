@@ -0,0 +1,230 @@
+package geo
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// GRS80 flattening, used by the SIRGAS, POSGAR and ETRS89 realizations. Their
+// datums are aligned with WGS84 well below survey precision, so no datum shift
+// is applied.
+const grs80F = 1 / 298.257222101
+
+// CRS is a projected or geographic coordinate reference system that can be
+// converted to WGS84 longitude and latitude (EPSG:4326).
+type CRS struct {
+	Code    int
+	Name    string
+	inverse func(x, y float64) (lon, lat float64)
+}
+
+// IsWGS84 reports whether the CRS already uses WGS84 longitude and latitude
+func (c *CRS) IsWGS84() bool {
+	return c.Code == 4326
+}
+
+// ToWGS84 converts projected coordinates, easting and northing in meters, to
+// WGS84 longitude and latitude in degrees
+func (c *CRS) ToWGS84(x, y float64) (lon, lat float64) {
+	if c.inverse == nil {
+		return x, y
+	}
+	return c.inverse(x, y)
+}
+
+// transverseMercator holds the constants of a Transverse Mercator projection,
+// inverted with the Krüger series to sixth order in the third flattening
+// (sub-millimeter within a few thousand kilometers of the central meridian).
+type transverseMercator struct {
+	lon0           float64
+	k0             float64
+	falseEasting   float64
+	falseNorthing  float64
+	radius         float64
+	originNorthing float64
+	alpha          [6]float64
+	beta           [6]float64
+	delta          [6]float64
+	eccentricity   float64
+}
+
+func newTransverseMercator(f, lat0, lon0, k0, falseEasting, falseNorthing float64) *transverseMercator {
+	n := f / (2 - f)
+	n2, n3, n4, n5, n6 := n*n, n*n*n, n*n*n*n, n*n*n*n*n, n*n*n*n*n*n
+
+	tm := &transverseMercator{
+		lon0:          lon0,
+		k0:            k0,
+		falseEasting:  falseEasting,
+		falseNorthing: falseNorthing,
+		radius:        wgs84A / (1 + n) * (1 + n2/4 + n4/64 + n6/256),
+		eccentricity:  math.Sqrt(f * (2 - f)),
+		alpha: [6]float64{
+			n/2 - 2*n2/3 + 5*n3/16 + 41*n4/180 - 127*n5/288 + 7891*n6/37800,
+			13*n2/48 - 3*n3/5 + 557*n4/1440 + 281*n5/630 - 1983433*n6/1935360,
+			61*n3/240 - 103*n4/140 + 15061*n5/26880 + 167603*n6/181440,
+			49561*n4/161280 - 179*n5/168 + 6601661*n6/7257600,
+			34729*n5/80640 - 3418889*n6/1995840,
+			212378941 * n6 / 319334400,
+		},
+		beta: [6]float64{
+			n/2 - 2*n2/3 + 37*n3/96 - n4/360 - 81*n5/512 + 96199*n6/604800,
+			n2/48 + n3/15 - 437*n4/1440 + 46*n5/105 - 1118711*n6/3870720,
+			17*n3/480 - 37*n4/840 - 209*n5/4480 + 5569*n6/90720,
+			4397*n4/161280 - 11*n5/504 - 830251*n6/7257600,
+			4583*n5/161280 - 108847*n6/3991680,
+			20648693 * n6 / 638668800,
+		},
+		delta: [6]float64{
+			2*n - 2*n2/3 - 2*n3 + 116*n4/45 + 26*n5/45 - 2854*n6/675,
+			7*n2/3 - 8*n3/5 - 227*n4/45 + 2704*n5/315 + 2323*n6/945,
+			56*n3/15 - 136*n4/35 - 1262*n5/105 + 73814*n6/2835,
+			4279*n4/630 - 332*n5/35 - 399572*n6/14175,
+			4174*n5/315 - 144838*n6/6237,
+			601676 * n6 / 22275,
+		},
+	}
+	tm.originNorthing = tm.meridianDistance(lat0)
+
+	return tm
+}
+
+// meridianDistance returns the scaled distance along the central meridian from
+// the equator to a latitude in degrees
+func (tm *transverseMercator) meridianDistance(lat float64) float64 {
+	if math.Abs(lat) == 90 {
+		return math.Copysign(tm.k0*tm.radius*math.Pi/2, lat)
+	}
+
+	sinLat := math.Sin(toRadians(lat))
+	t := math.Sinh(math.Atanh(sinLat) - tm.eccentricity*math.Atanh(tm.eccentricity*sinLat))
+	xi := math.Atan(t)
+	for j, alpha := range tm.alpha {
+		xi += alpha * math.Sin(2*float64(j+1)*xi)
+	}
+
+	return tm.k0 * tm.radius * xi
+}
+
+func (tm *transverseMercator) inverse(x, y float64) (lon, lat float64) {
+	xi := (y - tm.falseNorthing + tm.originNorthing) / (tm.k0 * tm.radius)
+	eta := (x - tm.falseEasting) / (tm.k0 * tm.radius)
+
+	xiPrime, etaPrime := xi, eta
+	for j, beta := range tm.beta {
+		k := 2 * float64(j+1)
+		xiPrime -= beta * math.Sin(k*xi) * math.Cosh(k*eta)
+		etaPrime -= beta * math.Cos(k*xi) * math.Sinh(k*eta)
+	}
+
+	chi := math.Asin(math.Sin(xiPrime) / math.Cosh(etaPrime))
+	phi := chi
+	for j, delta := range tm.delta {
+		phi += delta * math.Sin(2*float64(j+1)*chi)
+	}
+
+	lon = tm.lon0 + toDegrees(math.Atan2(math.Sinh(etaPrime), math.Cos(xiPrime)))
+	return normalizeLongitude(lon), toDegrees(phi)
+}
+
+// utm returns the inverse projection of a UTM zone
+func utm(f float64, zone int, south bool) func(x, y float64) (float64, float64) {
+	falseNorthing := 0.0
+	if south {
+		falseNorthing = 10000000
+	}
+	return newTransverseMercator(f, 0, float64(zone*6-183), 0.9996, 500000, falseNorthing).inverse
+}
+
+// argentina returns the inverse projection of an Argentina Gauss-Krüger zone
+func argentina(zone int) func(x, y float64) (float64, float64) {
+	return newTransverseMercator(grs80F, -90, float64(zone*3-75), 1, float64(zone)*1000000+500000, 0).inverse
+}
+
+// webMercator is the spherical Mercator used by web maps (EPSG:3857)
+func webMercator(x, y float64) (lon, lat float64) {
+	return toDegrees(x / wgs84A), toDegrees(math.Atan(math.Sinh(y / wgs84A)))
+}
+
+// crsCatalog lists the supported coordinate reference systems by EPSG code
+var crsCatalog = func() map[int]*CRS {
+	catalog := map[int]*CRS{
+		4326: {Code: 4326, Name: "WGS 84"},
+		3857: {Code: 3857, Name: "WGS 84 / Pseudo-Mercator", inverse: webMercator},
+	}
+
+	add := func(code int, name string, inverse func(x, y float64) (float64, float64)) {
+		catalog[code] = &CRS{Code: code, Name: name, inverse: inverse}
+	}
+
+	for zone := 1; zone <= 60; zone++ {
+		add(32600+zone, fmt.Sprintf("WGS 84 / UTM zone %dN", zone), utm(wgs84F, zone, false))
+		add(32700+zone, fmt.Sprintf("WGS 84 / UTM zone %dS", zone), utm(wgs84F, zone, true))
+	}
+	for zone := 11; zone <= 22; zone++ {
+		add(31954+zone, fmt.Sprintf("SIRGAS 2000 / UTM zone %dN", zone), utm(grs80F, zone, false))
+	}
+	for zone := 17; zone <= 25; zone++ {
+		add(31960+zone, fmt.Sprintf("SIRGAS 2000 / UTM zone %dS", zone), utm(grs80F, zone, true))
+	}
+	for zone := 28; zone <= 38; zone++ {
+		add(25800+zone, fmt.Sprintf("ETRS89 / UTM zone %dN", zone), utm(grs80F, zone, false))
+	}
+	for zone := 1; zone <= 7; zone++ {
+		add(22170+zone, fmt.Sprintf("POSGAR 98 / Argentina %d", zone), argentina(zone))
+		add(22180+zone, fmt.Sprintf("POSGAR 94 / Argentina %d", zone), argentina(zone))
+		add(5342+zone, fmt.Sprintf("POSGAR 2007 / Argentina %d", zone), argentina(zone))
+	}
+
+	return catalog
+}()
+
+// crsAliases are the non EPSG names accepted for WGS84
+var crsAliases = map[string]int{
+	"WGS84":     4326,
+	"CRS84":     4326,
+	"OGC:CRS84": 4326,
+}
+
+// CRSCodes returns the EPSG codes of the supported coordinate reference systems
+func CRSCodes() []int {
+	codes := make([]int, 0, len(crsCatalog))
+	for code := range crsCatalog {
+		codes = append(codes, code)
+	}
+	sort.Ints(codes)
+	return codes
+}
+
+// LookupCRS finds a coordinate reference system by its identifier, as
+// "EPSG:32719", "32719", "urn:ogc:def:crs:EPSG::32719" or "WGS84"
+func LookupCRS(identifier string) (*CRS, error) {
+	normalized := strings.ToUpper(strings.TrimSpace(identifier))
+	if code, ok := crsAliases[normalized]; ok {
+		return crsCatalog[code], nil
+	}
+
+	normalized = strings.TrimPrefix(normalized, "URN:OGC:DEF:CRS:")
+	normalized = strings.TrimPrefix(normalized, "EPSG:")
+	normalized = strings.TrimPrefix(normalized, ":")
+
+	code, err := strconv.Atoi(normalized)
+	if err != nil {
+		return nil, fmt.Errorf("invalid coordinate reference system %q, expected an EPSG code such as EPSG:32719", identifier)
+	}
+
+	crs, ok := crsCatalog[code]
+	if !ok {
+		return nil, fmt.Errorf(
+			"unsupported coordinate reference system EPSG:%d, supported ones are EPSG:4326, EPSG:3857, "+
+				"the WGS 84 (326xx, 327xx), SIRGAS 2000 (31965-31985) and ETRS89 (25828-25838) UTM zones "+
+				"and the POSGAR Argentina zones (22171-22177, 22181-22187, 5343-5349)",
+			code,
+		)
+	}
+
+	return crs, nil
+}
@@ -0,0 +1,114 @@
+package geo
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+)
+
+// Feature is a geometry along with the identifier, name and properties it had
+// in the file it was read from
+type Feature struct {
+	ID         string
+	Name       string
+	Properties map[string]any
+	Geometry   *Geometry
+}
+
+// geoJSONFeature holds the members of a GeoJSON Feature or FeatureCollection
+type geoJSONFeature struct {
+	Type       string            `json:"type"`
+	ID         any               `json:"id"`
+	Properties map[string]any    `json:"properties"`
+	Geometry   json.RawMessage   `json:"geometry"`
+	Features   []json.RawMessage `json:"features"`
+	CRS        *struct {
+		Properties struct {
+			Name string `json:"name"`
+		} `json:"properties"`
+	} `json:"crs"`
+}
+
+// ParseGeoJSONFeatures returns the features of a GeoJSON FeatureCollection. A
+// single Feature or a bare geometry is returned as a feature of its own.
+// Features without geometry are skipped.
+func ParseGeoJSONFeatures(s string) ([]Feature, error) {
+	var object geoJSONFeature
+	if err := json.Unmarshal([]byte(s), &object); err != nil {
+		return nil, fmt.Errorf("invalid GeoJSON: %w", err)
+	}
+
+	switch object.Type {
+	case "FeatureCollection":
+		var features []Feature
+		for i, raw := range object.Features {
+			var member geoJSONFeature
+			if err := json.Unmarshal(raw, &member); err != nil {
+				return nil, fmt.Errorf("invalid GeoJSON: features[%d]: %w", i, err)
+			}
+			feature, ok, err := member.feature()
+			if err != nil {
+				return nil, fmt.Errorf("invalid GeoJSON: features[%d]: %w", i, err)
+			}
+			if ok {
+				features = append(features, feature)
+			}
+		}
+		return features, nil
+	case "Feature":
+		feature, ok, err := object.feature()
+		if err != nil {
+			return nil, fmt.Errorf("invalid GeoJSON: %w", err)
+		}
+		if !ok {
+			return nil, nil
+		}
+		return []Feature{feature}, nil
+	}
+
+	geometry, err := ParseGeometry(s)
+	if err != nil {
+		return nil, err
+	}
+	return []Feature{{Properties: map[string]any{}, Geometry: geometry}}, nil
+}
+
+// GeoJSONCRS returns the reference system declared by the legacy crs member
+// of a GeoJSON object, or an empty string when there's none
+func GeoJSONCRS(s string) string {
+	var object geoJSONFeature
+	if err := json.Unmarshal([]byte(s), &object); err != nil || object.CRS == nil {
+		return ""
+	}
+	return object.CRS.Properties.Name
+}
+
+func (f geoJSONFeature) feature() (Feature, bool, error) {
+	if f.Type != "Feature" {
+		return Feature{}, false, fmt.Errorf("expected a Feature, got %q", f.Type)
+	}
+	if len(f.Geometry) == 0 || string(f.Geometry) == "null" {
+		return Feature{}, false, nil
+	}
+
+	geometry, err := ParseGeometry(string(f.Geometry))
+	if err != nil {
+		return Feature{}, false, err
+	}
+
+	feature := Feature{Properties: f.Properties, Geometry: geometry}
+	if feature.Properties == nil {
+		feature.Properties = map[string]any{}
+	}
+	switch id := f.ID.(type) {
+	case string:
+		feature.ID = id
+	case float64:
+		feature.ID = strconv.FormatFloat(id, 'f', -1, 64)
+	}
+	if name, ok := feature.Properties["name"].(string); ok {
+		feature.Name = name
+	}
+
+	return feature, true, nil
+}
@@ -0,0 +1,195 @@
+package geo
+
+import (
+	"math"
+)
+
+// WGS84 ellipsoid parameters
+const (
+	wgs84A = 6378137.0
+	wgs84F = 1 / 298.257223563
+	wgs84B = wgs84A * (1 - wgs84F)
+)
+
+const (
+	vincentyMaxIterations = 200
+	vincentyTolerance     = 1e-12
+)
+
+func toRadians(deg float64) float64 {
+	return deg * math.Pi / 180
+}
+
+func toDegrees(rad float64) float64 {
+	return rad * 180 / math.Pi
+}
+
+// normalizeAzimuth maps an angle in degrees to the [0, 360) range
+func normalizeAzimuth(deg float64) float64 {
+	deg = math.Mod(deg, 360)
+	if deg < 0 {
+		deg += 360
+	}
+	return deg
+}
+
+// normalizeLongitude maps a longitude in degrees to the [-180, 180) range
+func normalizeLongitude(deg float64) float64 {
+	return normalizeAzimuth(deg+180) - 180
+}
+
+// Inverse solves the inverse geodesic problem on the WGS84 ellipsoid using
+// Vincenty's formulae. It returns the distance in meters between both
+// positions and the initial azimuth in degrees, clockwise from true north.
+func Inverse(from, to Position) (distance, azimuth float64) {
+	if from.Lon == to.Lon && from.Lat == to.Lat {
+		return 0, 0
+	}
+
+	L := toRadians(to.Lon - from.Lon)
+	U1 := math.Atan((1 - wgs84F) * math.Tan(toRadians(from.Lat)))
+	U2 := math.Atan((1 - wgs84F) * math.Tan(toRadians(to.Lat)))
+	sinU1, cosU1 := math.Sincos(U1)
+	sinU2, cosU2 := math.Sincos(U2)
+
+	lambda := L
+	var sinSigma, cosSigma, sigma, cosSqAlpha, cos2SigmaM, sinLambda, cosLambda float64
+	converged := false
+
+	for i := 0; i < vincentyMaxIterations; i++ {
+		sinLambda, cosLambda = math.Sincos(lambda)
+		sinSigma = math.Sqrt(math.Pow(cosU2*sinLambda, 2) + math.Pow(cosU1*sinU2-sinU1*cosU2*cosLambda, 2))
+		if sinSigma == 0 {
+			// Coincident points
+			return 0, 0
+		}
+		cosSigma = sinU1*sinU2 + cosU1*cosU2*cosLambda
+		sigma = math.Atan2(sinSigma, cosSigma)
+		sinAlpha := cosU1 * cosU2 * sinLambda / sinSigma
+		cosSqAlpha = 1 - sinAlpha*sinAlpha
+		cos2SigmaM = 0
+		if cosSqAlpha != 0 {
+			// Equatorial lines have cosSqAlpha = 0
+			cos2SigmaM = cosSigma - 2*sinU1*sinU2/cosSqAlpha
+		}
+		C := wgs84F / 16 * cosSqAlpha * (4 + wgs84F*(4-3*cosSqAlpha))
+		previous := lambda
+		lambda = L + (1-C)*wgs84F*sinAlpha*(sigma+C*sinSigma*(cos2SigmaM+C*cosSigma*(-1+2*cos2SigmaM*cos2SigmaM)))
+		if math.Abs(lambda-previous) < vincentyTolerance {
+			converged = true
+			break
+		}
+	}
+
+	if !converged {
+		// Nearly antipodal points, fall back to the spherical solution
+		return haversine(from, to)
+	}
+
+	uSq := cosSqAlpha * (wgs84A*wgs84A - wgs84B*wgs84B) / (wgs84B * wgs84B)
+	A := 1 + uSq/16384*(4096+uSq*(-768+uSq*(320-175*uSq)))
+	B := uSq / 1024 * (256 + uSq*(-128+uSq*(74-47*uSq)))
+	deltaSigma := B * sinSigma * (cos2SigmaM + B/4*(cosSigma*(-1+2*cos2SigmaM*cos2SigmaM)-
+		B/6*cos2SigmaM*(-3+4*sinSigma*sinSigma)*(-3+4*cos2SigmaM*cos2SigmaM)))
+
+	distance = wgs84B * A * (sigma - deltaSigma)
+	azimuth = normalizeAzimuth(toDegrees(math.Atan2(cosU2*sinLambda, cosU1*sinU2-sinU1*cosU2*cosLambda)))
+
+	return distance, azimuth
+}
+
+// Direct solves the direct geodesic problem on the WGS84 ellipsoid using
+// Vincenty's formulae. It returns the position reached after travelling
+// distance meters from the origin along the given initial azimuth.
+func Direct(from Position, azimuth, distance float64) Position {
+	alpha1 := toRadians(azimuth)
+	sinAlpha1, cosAlpha1 := math.Sincos(alpha1)
+
+	tanU1 := (1 - wgs84F) * math.Tan(toRadians(from.Lat))
+	cosU1 := 1 / math.Sqrt(1+tanU1*tanU1)
+	sinU1 := tanU1 * cosU1
+
+	sigma1 := math.Atan2(tanU1, cosAlpha1)
+	sinAlpha := cosU1 * sinAlpha1
+	cosSqAlpha := 1 - sinAlpha*sinAlpha
+	uSq := cosSqAlpha * (wgs84A*wgs84A - wgs84B*wgs84B) / (wgs84B * wgs84B)
+	A := 1 + uSq/16384*(4096+uSq*(-768+uSq*(320-175*uSq)))
+	B := uSq / 1024 * (256 + uSq*(-128+uSq*(74-47*uSq)))
+
+	sigma := distance / (wgs84B * A)
+	var sinSigma, cosSigma, cos2SigmaM float64
+	for i := 0; i < vincentyMaxIterations; i++ {
+		cos2SigmaM = math.Cos(2*sigma1 + sigma)
+		sinSigma, cosSigma = math.Sincos(sigma)
+		deltaSigma := B * sinSigma * (cos2SigmaM + B/4*(cosSigma*(-1+2*cos2SigmaM*cos2SigmaM)-
+			B/6*cos2SigmaM*(-3+4*sinSigma*sinSigma)*(-3+4*cos2SigmaM*cos2SigmaM)))
+		previous := sigma
+		sigma = distance/(wgs84B*A) + deltaSigma
+		if math.Abs(sigma-previous) < vincentyTolerance {
+			break
+		}
+	}
+	sinSigma, cosSigma = math.Sincos(sigma)
+	cos2SigmaM = math.Cos(2*sigma1 + sigma)
+
+	x := sinU1*sinSigma - cosU1*cosSigma*cosAlpha1
+	lat2 := math.Atan2(sinU1*cosSigma+cosU1*sinSigma*cosAlpha1, (1-wgs84F)*math.Sqrt(sinAlpha*sinAlpha+x*x))
+	lambda := math.Atan2(sinSigma*sinAlpha1, cosU1*cosSigma-sinU1*sinSigma*cosAlpha1)
+	C := wgs84F / 16 * cosSqAlpha * (4 + wgs84F*(4-3*cosSqAlpha))
+	L := lambda - (1-C)*wgs84F*sinAlpha*(sigma+C*sinSigma*(cos2SigmaM+C*cosSigma*(-1+2*cos2SigmaM*cos2SigmaM)))
+
+	return Position{
+		Lon: normalizeLongitude(from.Lon + toDegrees(L)),
+		Lat: toDegrees(lat2),
+	}
+}
+
+// haversine computes the great-circle distance and initial bearing on a
+// sphere with the WGS84 mean radius.
+func haversine(from, to Position) (distance, azimuth float64) {
+	const meanRadius = (2*wgs84A + wgs84B) / 3
+
+	phi1 := toRadians(from.Lat)
+	phi2 := toRadians(to.Lat)
+	deltaPhi := phi2 - phi1
+	deltaLambda := toRadians(to.Lon - from.Lon)
+
+	h := math.Pow(math.Sin(deltaPhi/2), 2) + math.Cos(phi1)*math.Cos(phi2)*math.Pow(math.Sin(deltaLambda/2), 2)
+	distance = 2 * meanRadius * math.Asin(math.Min(1, math.Sqrt(h)))
+
+	y := math.Sin(deltaLambda) * math.Cos(phi2)
+	x := math.Cos(phi1)*math.Sin(phi2) - math.Sin(phi1)*math.Cos(phi2)*math.Cos(deltaLambda)
+	azimuth = normalizeAzimuth(toDegrees(math.Atan2(y, x)))
+
+	return distance, azimuth
+}
+
+// Midpoint returns the position halfway along the geodesic between two positions.
+// Altitudes, when present in both ends, are linearly interpolated.
+func Midpoint(from, to Position) Position {
+	distance, azimuth := Inverse(from, to)
+	midpoint := Direct(from, azimuth, distance/2)
+
+	if from.HasAlt && to.HasAlt {
+		midpoint.Alt = (from.Alt + to.Alt) / 2
+		midpoint.HasAlt = true
+	}
+
+	return midpoint
+}
+
+// Length returns the geodesic length in meters of a sequence of positions
+func Length(positions []Position) float64 {
+	total := 0.0
+	for i := 1; i < len(positions); i++ {
+		distance, _ := Inverse(positions[i-1], positions[i])
+		total += distance
+	}
+	return total
+}
+
+// Round rounds a value to the given number of decimal places
+func Round(value float64, decimals int) float64 {
+	factor := math.Pow(10, float64(decimals))
+	return math.Round(value*factor) / factor
+}
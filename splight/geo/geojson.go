@@ -0,0 +1,131 @@
+package geo
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// Position is a GeoJSON position expressed as longitude, latitude and an
+// optional altitude, following RFC 7946 axis order.
+type Position struct {
+	Lon    float64
+	Lat    float64
+	Alt    float64
+	HasAlt bool
+}
+
+func (p Position) MarshalJSON() ([]byte, error) {
+	if p.HasAlt {
+		return json.Marshal([]float64{p.Lon, p.Lat, p.Alt})
+	}
+	return json.Marshal([]float64{p.Lon, p.Lat})
+}
+
+func (p *Position) UnmarshalJSON(data []byte) error {
+	var values []float64
+	if err := json.Unmarshal(data, &values); err != nil {
+		return fmt.Errorf("position must be an array of numbers")
+	}
+	if len(values) < 2 {
+		return fmt.Errorf("position must have at least two elements, got %d", len(values))
+	}
+
+	*p = Position{Lon: values[0], Lat: values[1]}
+	if len(values) > 2 {
+		p.Alt = values[2]
+		p.HasAlt = true
+	}
+
+	return nil
+}
+
+// Geometry is a GeoJSON geometry object. Coordinates are kept raw and decoded
+// on demand, since their nesting depends on the geometry type.
+type Geometry struct {
+	Type        string          `json:"type"`
+	Coordinates json.RawMessage `json:"coordinates,omitempty"`
+	Geometries  []*Geometry     `json:"geometries,omitempty"`
+}
+
+// ParseGeometry decodes a JSON encoded GeoJSON geometry
+func ParseGeometry(s string) (*Geometry, error) {
+	var g Geometry
+	if err := json.Unmarshal([]byte(s), &g); err != nil {
+		return nil, fmt.Errorf("invalid GeoJSON: %w", err)
+	}
+	if g.Type == "" {
+		return nil, fmt.Errorf("invalid GeoJSON: missing type")
+	}
+	return &g, nil
+}
+
+// NewPoint builds a Point geometry
+func NewPoint(p Position) *Geometry {
+	coordinates, _ := json.Marshal(p)
+	return &Geometry{Type: "Point", Coordinates: coordinates}
+}
+
+// NewGeometryCollection wraps the given geometries in a GeometryCollection
+func NewGeometryCollection(geometries ...*Geometry) *Geometry {
+	return &Geometry{Type: "GeometryCollection", Geometries: geometries}
+}
+
+// String returns the JSON encoding of the geometry
+func (g *Geometry) String() string {
+	if g.Type == "GeometryCollection" && len(g.Geometries) == 0 {
+		// "geometries" is mandatory even when empty
+		return `{"type":"GeometryCollection","geometries":[]}`
+	}
+	encoded, _ := json.Marshal(g)
+	return string(encoded)
+}
+
+// LineStrings returns the vertices of every LineString found in the geometry,
+// including the members of MultiLineStrings and GeometryCollections.
+func (g *Geometry) LineStrings() ([][]Position, error) {
+	switch g.Type {
+	case "LineString":
+		var line []Position
+		if err := json.Unmarshal(g.Coordinates, &line); err != nil {
+			return nil, fmt.Errorf("invalid LineString coordinates: %w", err)
+		}
+		return [][]Position{line}, nil
+	case "MultiLineString":
+		var lines [][]Position
+		if err := json.Unmarshal(g.Coordinates, &lines); err != nil {
+			return nil, fmt.Errorf("invalid MultiLineString coordinates: %w", err)
+		}
+		return lines, nil
+	case "GeometryCollection":
+		var lines [][]Position
+		for _, member := range g.Geometries {
+			memberLines, err := member.LineStrings()
+			if err != nil {
+				return nil, err
+			}
+			lines = append(lines, memberLines...)
+		}
+		return lines, nil
+	}
+
+	return nil, nil
+}
+
+// Length returns the geodesic length in meters of every LineString and
+// MultiLineString in the geometry. It fails if the geometry has no lines.
+func (g *Geometry) Length() (float64, error) {
+	lines, err := g.LineStrings()
+	if err != nil {
+		return 0, err
+	}
+	if len(lines) == 0 {
+		return 0, fmt.Errorf("geometry has no LineString or MultiLineString")
+	}
+
+	total := 0.0
+	for _, line := range lines {
+		total += Length(line)
+	}
+
+	return total, nil
+}
@@ -0,0 +1,146 @@
+package geo
+
+// Intersects reports whether two geometries share at least one position, in
+// planar lon/lat coordinates. Boundaries count, so touching geometries
+// intersect. Geometries crossing the antimeridian aren't supported.
+func (g *Geometry) Intersects(other *Geometry) (bool, error) {
+	a, err := g.parts()
+	if err != nil {
+		return false, err
+	}
+	b, err := other.parts()
+	if err != nil {
+		return false, err
+	}
+
+	if !boundsOverlap(a.positions(), b.positions()) {
+		return false, nil
+	}
+
+	// Crossing edges, including touching ones, points lying on a line and
+	// equal points
+	segmentsA, segmentsB := a.segments(), b.segments()
+	for _, sa := range segmentsA {
+		for _, sb := range segmentsB {
+			if segmentsIntersect(sa[0], sa[1], sb[0], sb[1]) {
+				return true, nil
+			}
+		}
+	}
+
+	// Otherwise one of them is either fully inside the other or outside it,
+	// so checking a single position of each one is enough
+	return a.containsAny(b) || b.containsAny(a), nil
+}
+
+// boundsOverlap reports whether the bounding boxes of two sets of positions overlap
+func boundsOverlap(a, b []Position) bool {
+	if len(a) == 0 || len(b) == 0 {
+		return false
+	}
+	minA, maxA := bounds(a)
+	minB, maxB := bounds(b)
+	return minA.Lon <= maxB.Lon && minB.Lon <= maxA.Lon && minA.Lat <= maxB.Lat && minB.Lat <= maxA.Lat
+}
+
+func bounds(positions []Position) (Position, Position) {
+	lower, upper := positions[0], positions[0]
+	for _, p := range positions[1:] {
+		lower.Lon, lower.Lat = min(lower.Lon, p.Lon), min(lower.Lat, p.Lat)
+		upper.Lon, upper.Lat = max(upper.Lon, p.Lon), max(upper.Lat, p.Lat)
+	}
+	return lower, upper
+}
+
+// segments returns the segments of the lines and polygon rings, with points
+// as zero length segments so they're found on the edges of the other geometry
+func (p parts) segments() [][2]Position {
+	var segments [][2]Position
+	for _, point := range p.points {
+		segments = append(segments, [2]Position{point, point})
+	}
+	add := func(line []Position) {
+		for i := 1; i < len(line); i++ {
+			segments = append(segments, [2]Position{line[i-1], line[i]})
+		}
+	}
+	for _, line := range p.lines {
+		add(line)
+	}
+	for _, polygon := range p.polygons {
+		for _, ring := range polygon {
+			add(ring)
+		}
+	}
+	return segments
+}
+
+// containsAny reports whether a position of the other geometry is inside one
+// of the polygons, one per part being enough once no edges cross
+func (p parts) containsAny(other parts) bool {
+	var probes []Position
+	probes = append(probes, other.points...)
+	for _, line := range other.lines {
+		if len(line) > 0 {
+			probes = append(probes, line[0])
+		}
+	}
+	for _, polygon := range other.polygons {
+		if len(polygon) > 0 && len(polygon[0]) > 0 {
+			probes = append(probes, polygon[0][0])
+		}
+	}
+
+	for _, polygon := range p.polygons {
+		for _, probe := range probes {
+			if polygonContains(polygon, probe) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// polygonContains reports whether a position is inside the outer ring of a
+// polygon and outside its holes, by the even-odd rule
+func polygonContains(polygon [][]Position, p Position) bool {
+	inside := false
+	for _, ring := range polygon {
+		for i, j := 0, len(ring)-1; i < len(ring); j, i = i, i+1 {
+			a, b := ring[i], ring[j]
+			if (a.Lat > p.Lat) != (b.Lat > p.Lat) && p.Lon < (b.Lon-a.Lon)*(p.Lat-a.Lat)/(b.Lat-a.Lat)+a.Lon {
+				inside = !inside
+			}
+		}
+	}
+	return inside
+}
+
+// orientation returns the sign of the turn from a to b to c: positive when
+// counterclockwise, negative when clockwise and 0 when collinear
+func orientation(a, b, c Position) float64 {
+	return (b.Lon-a.Lon)*(c.Lat-a.Lat) - (b.Lat-a.Lat)*(c.Lon-a.Lon)
+}
+
+// onSegment reports whether c, collinear with a and b, lies between them
+func onSegment(a, b, c Position) bool {
+	return min(a.Lon, b.Lon) <= c.Lon && c.Lon <= max(a.Lon, b.Lon) &&
+		min(a.Lat, b.Lat) <= c.Lat && c.Lat <= max(a.Lat, b.Lat)
+}
+
+// segmentsIntersect reports whether segment p1-p2 and segment q1-q2 share a position
+func segmentsIntersect(p1, p2, q1, q2 Position) bool {
+	d1 := orientation(q1, q2, p1)
+	d2 := orientation(q1, q2, p2)
+	d3 := orientation(p1, p2, q1)
+	d4 := orientation(p1, p2, q2)
+
+	if ((d1 > 0 && d2 < 0) || (d1 < 0 && d2 > 0)) && ((d3 > 0 && d4 < 0) || (d3 < 0 && d4 > 0)) {
+		return true
+	}
+
+	return (d1 == 0 && onSegment(q1, q2, p1)) ||
+		(d2 == 0 && onSegment(q1, q2, p2)) ||
+		(d3 == 0 && onSegment(p1, p2, q1)) ||
+		(d4 == 0 && onSegment(p1, p2, q2))
+}
@@ -0,0 +1,234 @@
+package geo
+
+import (
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// kmlElement is a generic KML element, enough to walk the geometries of a
+// document regardless of how Placemarks are nested in Folders or Documents
+type kmlElement struct {
+	XMLName  xml.Name
+	Attrs    []xml.Attr   `xml:",any,attr"`
+	Children []kmlElement `xml:",any"`
+	Text     string       `xml:",chardata"`
+}
+
+func (e kmlElement) attr(name string) string {
+	for _, attr := range e.Attrs {
+		if attr.Name.Local == name {
+			return attr.Value
+		}
+	}
+	return ""
+}
+
+func (e kmlElement) child(name string) (kmlElement, bool) {
+	for _, child := range e.Children {
+		if child.XMLName.Local == name {
+			return child, true
+		}
+	}
+	return kmlElement{}, false
+}
+
+func (e kmlElement) childrenNamed(name string) []kmlElement {
+	var children []kmlElement
+	for _, child := range e.Children {
+		if child.XMLName.Local == name {
+			children = append(children, child)
+		}
+	}
+	return children
+}
+
+// ParseKML returns the Point, LineString and Polygon geometries of a KML
+// document as a GeometryCollection. MultiGeometry elements are flattened.
+func ParseKML(s string) (*Geometry, error) {
+	var root kmlElement
+	if err := xml.Unmarshal([]byte(s), &root); err != nil {
+		return nil, fmt.Errorf("invalid KML: %w", err)
+	}
+
+	geometries, err := kmlGeometries(root)
+	if err != nil {
+		return nil, fmt.Errorf("invalid KML: %w", err)
+	}
+	if len(geometries) == 0 {
+		return nil, fmt.Errorf("invalid KML: no Point, LineString or Polygon found")
+	}
+
+	return NewGeometryCollection(geometries...), nil
+}
+
+// ParseKMLFeatures returns every Placemark of a KML document with a geometry
+// as a feature. Its id attribute, name and ExtendedData are kept, and
+// MultiGeometries become GeometryCollections.
+func ParseKMLFeatures(s string) ([]Feature, error) {
+	var root kmlElement
+	if err := xml.Unmarshal([]byte(s), &root); err != nil {
+		return nil, fmt.Errorf("invalid KML: %w", err)
+	}
+
+	var features []Feature
+	var walk func(element kmlElement) error
+	walk = func(element kmlElement) error {
+		if element.XMLName.Local != "Placemark" {
+			for _, child := range element.Children {
+				if err := walk(child); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+
+		geometries, err := kmlGeometries(element)
+		if err != nil {
+			return fmt.Errorf("placemark %d: %w", len(features), err)
+		}
+		if len(geometries) == 0 {
+			return nil
+		}
+
+		feature := Feature{
+			ID:         element.attr("id"),
+			Properties: kmlProperties(element),
+			Geometry:   geometries[0],
+		}
+		if name, ok := element.child("name"); ok {
+			feature.Name = strings.TrimSpace(name.Text)
+		}
+		if len(geometries) > 1 {
+			feature.Geometry = NewGeometryCollection(geometries...)
+		}
+
+		features = append(features, feature)
+		return nil
+	}
+
+	if err := walk(root); err != nil {
+		return nil, fmt.Errorf("invalid KML: %w", err)
+	}
+	return features, nil
+}
+
+// kmlProperties returns the description and the ExtendedData values of a
+// Placemark, both untyped Data and SchemaData
+func kmlProperties(placemark kmlElement) map[string]any {
+	properties := map[string]any{}
+
+	if description, ok := placemark.child("description"); ok {
+		properties["description"] = strings.TrimSpace(description.Text)
+	}
+
+	extendedData, ok := placemark.child("ExtendedData")
+	if !ok {
+		return properties
+	}
+	for _, data := range extendedData.childrenNamed("Data") {
+		if value, ok := data.child("value"); ok {
+			properties[data.attr("name")] = strings.TrimSpace(value.Text)
+		}
+	}
+	for _, schemaData := range extendedData.childrenNamed("SchemaData") {
+		for _, data := range schemaData.childrenNamed("SimpleData") {
+			properties[data.attr("name")] = strings.TrimSpace(data.Text)
+		}
+	}
+
+	return properties
+}
+
+func kmlGeometries(element kmlElement) ([]*Geometry, error) {
+	var coordinates any
+	var geometryType string
+
+	switch element.XMLName.Local {
+	case "Point":
+		positions, err := kmlCoordinates(element)
+		if err != nil {
+			return nil, err
+		}
+		if len(positions) != 1 {
+			return nil, fmt.Errorf("Point must have one position, got %d", len(positions))
+		}
+		geometryType, coordinates = "Point", positions[0]
+	case "LineString":
+		positions, err := kmlCoordinates(element)
+		if err != nil {
+			return nil, err
+		}
+		geometryType, coordinates = "LineString", positions
+	case "Polygon":
+		var rings [][]Position
+		boundaries := element.childrenNamed("outerBoundaryIs")
+		boundaries = append(boundaries, element.childrenNamed("innerBoundaryIs")...)
+		for _, boundary := range boundaries {
+			ring, ok := boundary.child("LinearRing")
+			if !ok {
+				return nil, fmt.Errorf("polygon boundary without LinearRing")
+			}
+			positions, err := kmlCoordinates(ring)
+			if err != nil {
+				return nil, err
+			}
+			rings = append(rings, positions)
+		}
+		geometryType, coordinates = "Polygon", rings
+	default:
+		// Documents, Folders, Placemarks and MultiGeometries
+		var geometries []*Geometry
+		for _, child := range element.Children {
+			childGeometries, err := kmlGeometries(child)
+			if err != nil {
+				return nil, err
+			}
+			geometries = append(geometries, childGeometries...)
+		}
+		return geometries, nil
+	}
+
+	encoded, err := json.Marshal(coordinates)
+	if err != nil {
+		return nil, err
+	}
+	return []*Geometry{{Type: geometryType, Coordinates: encoded}}, nil
+}
+
+// kmlCoordinates parses a coordinates element, made of "lon,lat[,alt]"
+// tuples separated by whitespace
+func kmlCoordinates(element kmlElement) ([]Position, error) {
+	coordinates, ok := element.child("coordinates")
+	if !ok {
+		return nil, fmt.Errorf("%s without coordinates", element.XMLName.Local)
+	}
+
+	var positions []Position
+	for _, tuple := range strings.Fields(coordinates.Text) {
+		parts := strings.Split(tuple, ",")
+		if len(parts) < 2 || len(parts) > 3 {
+			return nil, fmt.Errorf("invalid coordinates %q, expected lon,lat[,alt]", tuple)
+		}
+
+		values := make([]float64, len(parts))
+		for i, part := range parts {
+			value, err := strconv.ParseFloat(part, 64)
+			if err != nil {
+				return nil, fmt.Errorf("invalid coordinates %q: %w", tuple, err)
+			}
+			values[i] = value
+		}
+
+		position := Position{Lon: values[0], Lat: values[1]}
+		if len(values) == 3 {
+			position.Alt = values[2]
+			position.HasAlt = true
+		}
+		positions = append(positions, position)
+	}
+
+	return positions, nil
+}
@@ -0,0 +1,179 @@
+package geo
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+)
+
+// parts holds the positions of a geometry by dimension
+type parts struct {
+	points   []Position
+	lines    [][]Position
+	polygons [][][]Position
+}
+
+func (g *Geometry) parts() (parts, error) {
+	var result parts
+
+	decode := func(target any) error {
+		if err := json.Unmarshal(g.Coordinates, target); err != nil {
+			return fmt.Errorf("invalid %s coordinates: %w", g.Type, err)
+		}
+		return nil
+	}
+
+	switch g.Type {
+	case "Point":
+		var point Position
+		if err := decode(&point); err != nil {
+			return result, err
+		}
+		result.points = []Position{point}
+	case "MultiPoint":
+		if err := decode(&result.points); err != nil {
+			return result, err
+		}
+	case "LineString":
+		var line []Position
+		if err := decode(&line); err != nil {
+			return result, err
+		}
+		result.lines = [][]Position{line}
+	case "MultiLineString":
+		if err := decode(&result.lines); err != nil {
+			return result, err
+		}
+	case "Polygon":
+		var polygon [][]Position
+		if err := decode(&polygon); err != nil {
+			return result, err
+		}
+		result.polygons = [][][]Position{polygon}
+	case "MultiPolygon":
+		if err := decode(&result.polygons); err != nil {
+			return result, err
+		}
+	case "GeometryCollection":
+		for _, member := range g.Geometries {
+			memberParts, err := member.parts()
+			if err != nil {
+				return result, err
+			}
+			result.points = append(result.points, memberParts.points...)
+			result.lines = append(result.lines, memberParts.lines...)
+			result.polygons = append(result.polygons, memberParts.polygons...)
+		}
+	default:
+		return result, fmt.Errorf("unknown geometry type %q", g.Type)
+	}
+
+	return result, nil
+}
+
+// positions returns every position of the geometry
+func (p parts) positions() []Position {
+	positions := append([]Position{}, p.points...)
+	for _, line := range p.lines {
+		positions = append(positions, line...)
+	}
+	for _, polygon := range p.polygons {
+		for _, ring := range polygon {
+			positions = append(positions, ring...)
+		}
+	}
+	return positions
+}
+
+// BoundingBox returns the extent of the geometry as [west, south, east, north]
+// in degrees, as the RFC 7946 bbox member. It fails for empty geometries.
+func (g *Geometry) BoundingBox() ([4]float64, error) {
+	geometryParts, err := g.parts()
+	if err != nil {
+		return [4]float64{}, err
+	}
+
+	positions := geometryParts.positions()
+	if len(positions) == 0 {
+		return [4]float64{}, fmt.Errorf("geometry is empty")
+	}
+
+	bbox := [4]float64{math.Inf(1), math.Inf(1), math.Inf(-1), math.Inf(-1)}
+	for _, position := range positions {
+		bbox[0] = math.Min(bbox[0], position.Lon)
+		bbox[1] = math.Min(bbox[1], position.Lat)
+		bbox[2] = math.Max(bbox[2], position.Lon)
+		bbox[3] = math.Max(bbox[3], position.Lat)
+	}
+
+	return bbox, nil
+}
+
+// Centroid returns the center of mass of the geometry in planar lon/lat
+// coordinates. As in most GIS tools, only the parts with the highest
+// dimension count: polygons by area, lines by length and points equally.
+func (g *Geometry) Centroid() (Position, error) {
+	geometryParts, err := g.parts()
+	if err != nil {
+		return Position{}, err
+	}
+
+	var sumLon, sumLat, weight float64
+
+	for _, polygon := range geometryParts.polygons {
+		for i, ring := range polygon {
+			// Holes subtract their area, whatever their winding order
+			sign := 1.0
+			if i > 0 {
+				sign = -1
+			}
+			area, lon, lat := ringCentroid(ring)
+			sumLon += sign * math.Abs(area) * lon
+			sumLat += sign * math.Abs(area) * lat
+			weight += sign * math.Abs(area)
+		}
+	}
+	if weight > 0 {
+		return Position{Lon: sumLon / weight, Lat: sumLat / weight}, nil
+	}
+
+	for _, line := range geometryParts.lines {
+		for i := 1; i < len(line); i++ {
+			length := math.Hypot(line[i].Lon-line[i-1].Lon, line[i].Lat-line[i-1].Lat)
+			sumLon += length * (line[i].Lon + line[i-1].Lon) / 2
+			sumLat += length * (line[i].Lat + line[i-1].Lat) / 2
+			weight += length
+		}
+	}
+	if weight > 0 {
+		return Position{Lon: sumLon / weight, Lat: sumLat / weight}, nil
+	}
+
+	// Points, and degenerate lines or polygons collapsed to a point
+	positions := geometryParts.positions()
+	if len(positions) == 0 {
+		return Position{}, fmt.Errorf("geometry is empty")
+	}
+	for _, position := range positions {
+		sumLon += position.Lon
+		sumLat += position.Lat
+	}
+	count := float64(len(positions))
+
+	return Position{Lon: sumLon / count, Lat: sumLat / count}, nil
+}
+
+// ringCentroid returns the signed area and centroid of a closed ring
+func ringCentroid(ring []Position) (area, lon, lat float64) {
+	for i := 1; i < len(ring); i++ {
+		cross := ring[i-1].Lon*ring[i].Lat - ring[i].Lon*ring[i-1].Lat
+		area += cross
+		lon += (ring[i-1].Lon + ring[i].Lon) * cross
+		lat += (ring[i-1].Lat + ring[i].Lat) * cross
+	}
+	area /= 2
+	if area == 0 {
+		return 0, 0, 0
+	}
+	return area, lon / (6 * area), lat / (6 * area)
+}
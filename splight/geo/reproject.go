@@ -0,0 +1,108 @@
+package geo
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// Reproject converts every geometry position of a GeoJSON object from the
+// given CRS to WGS84 longitude and latitude. Geometries, Features and
+// FeatureCollections are accepted and their structure is kept; altitudes and
+// properties are left untouched.
+func Reproject(geojson string, crs *CRS) (string, error) {
+	if crs.IsWGS84() {
+		return geojson, nil
+	}
+
+	var object any
+	if err := json.Unmarshal([]byte(geojson), &object); err != nil {
+		return "", fmt.Errorf("invalid GeoJSON: %w", err)
+	}
+
+	reprojected, err := reprojectObject(object, crs)
+	if err != nil {
+		return "", err
+	}
+
+	encoded, err := json.Marshal(reprojected)
+	if err != nil {
+		return "", err
+	}
+	return string(encoded), nil
+}
+
+// reprojectObject reprojects the geometry members of a decoded GeoJSON
+// object. Only coordinates of geometries are converted, any other member,
+// such as the properties of a Feature, is kept as it is.
+func reprojectObject(object any, crs *CRS) (any, error) {
+	value, ok := object.(map[string]any)
+	if !ok {
+		return object, nil
+	}
+
+	var err error
+	switch value["type"] {
+	case "FeatureCollection":
+		features, _ := value["features"].([]any)
+		for i, feature := range features {
+			if features[i], err = reprojectObject(feature, crs); err != nil {
+				return nil, err
+			}
+		}
+	case "Feature":
+		if geometry, ok := value["geometry"]; ok && geometry != nil {
+			if value["geometry"], err = reprojectObject(geometry, crs); err != nil {
+				return nil, err
+			}
+		}
+	case "GeometryCollection":
+		geometries, _ := value["geometries"].([]any)
+		for i, geometry := range geometries {
+			if geometries[i], err = reprojectObject(geometry, crs); err != nil {
+				return nil, err
+			}
+		}
+	default:
+		if coordinates, ok := value["coordinates"]; ok {
+			if value["coordinates"], err = reprojectCoordinates(coordinates, crs); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	return object, nil
+}
+
+// reprojectCoordinates converts a position or an array of positions at any depth
+func reprojectCoordinates(coordinates any, crs *CRS) (any, error) {
+	values, ok := coordinates.([]any)
+	if !ok {
+		return nil, fmt.Errorf("coordinates must be an array")
+	}
+	if len(values) == 0 {
+		return values, nil
+	}
+
+	if _, isNumber := values[0].(float64); !isNumber {
+		for i, member := range values {
+			reprojected, err := reprojectCoordinates(member, crs)
+			if err != nil {
+				return nil, err
+			}
+			values[i] = reprojected
+		}
+		return values, nil
+	}
+
+	if len(values) < 2 {
+		return nil, fmt.Errorf("position must have at least two elements, got %d", len(values))
+	}
+	x, okX := values[0].(float64)
+	y, okY := values[1].(float64)
+	if !okX || !okY {
+		return nil, fmt.Errorf("position must be an array of numbers")
+	}
+
+	values[0], values[1] = crs.ToWGS84(x, y)
+	return values, nil
+}
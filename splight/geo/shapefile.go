@@ -0,0 +1,471 @@
+package geo
+
+import (
+	"archive/zip"
+	"bytes"
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math"
+	"path"
+	"regexp"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+)
+
+// Shapefile holds the files of an ESRI shapefile. Only the .shp is mandatory,
+// features have no properties without the .dbf and the reference system is
+// unknown without the .prj.
+type Shapefile struct {
+	Shp []byte
+	Dbf []byte
+	Prj string
+}
+
+// ReadShapefileZip extracts the shapefile of a zip archive. It fails if the
+// archive holds more than one.
+func ReadShapefileZip(data []byte) (*Shapefile, error) {
+	archive, err := zip.NewReader(bytes.NewReader(data), int64(len(data)))
+	if err != nil {
+		return nil, fmt.Errorf("invalid zip file: %w", err)
+	}
+
+	files := map[string]*zip.File{}
+	var shapefiles []string
+	for _, file := range archive.File {
+		name := strings.ToLower(file.Name)
+		if strings.HasPrefix(path.Base(name), ".") || strings.HasPrefix(name, "__macosx/") {
+			continue
+		}
+		files[name] = file
+		if path.Ext(name) == ".shp" {
+			shapefiles = append(shapefiles, strings.TrimSuffix(name, ".shp"))
+		}
+	}
+
+	switch len(shapefiles) {
+	case 0:
+		return nil, fmt.Errorf("zip file has no .shp file")
+	case 1:
+	default:
+		return nil, fmt.Errorf("zip file has %d shapefiles, expected one: %s", len(shapefiles), strings.Join(shapefiles, ", "))
+	}
+
+	read := func(extension string) ([]byte, error) {
+		file, ok := files[shapefiles[0]+extension]
+		if !ok {
+			return nil, nil
+		}
+		reader, err := file.Open()
+		if err != nil {
+			return nil, err
+		}
+		defer reader.Close()
+		return io.ReadAll(reader)
+	}
+
+	var shapefile Shapefile
+	if shapefile.Shp, err = read(".shp"); err != nil {
+		return nil, err
+	}
+	if shapefile.Dbf, err = read(".dbf"); err != nil {
+		return nil, err
+	}
+	prj, err := read(".prj")
+	if err != nil {
+		return nil, err
+	}
+	shapefile.Prj = string(prj)
+
+	return &shapefile, nil
+}
+
+// Shapefile shape types, the Z and M variants share the layout of the 2D ones
+// followed by the extra values
+const (
+	shapeNull        = 0
+	shapePoint       = 1
+	shapePolyLine    = 3
+	shapePolygon     = 5
+	shapeMultiPoint  = 8
+	shapeDimensionsZ = 10
+	shapeDimensionsM = 20
+)
+
+// Features returns the shapes of the .shp with the attributes of the matching
+// .dbf records. Null shapes and deleted records are skipped.
+func (s *Shapefile) Features() ([]Feature, error) {
+	if len(s.Shp) < 100 || binary.BigEndian.Uint32(s.Shp[0:4]) != 9994 {
+		return nil, fmt.Errorf("invalid .shp file: bad header")
+	}
+
+	var records []map[string]any
+	if s.Dbf != nil {
+		var err error
+		if records, err = readDBF(s.Dbf); err != nil {
+			return nil, fmt.Errorf("invalid .dbf file: %w", err)
+		}
+	}
+
+	var features []Feature
+	for offset, index := 100, 0; offset+8 <= len(s.Shp); index++ {
+		number := binary.BigEndian.Uint32(s.Shp[offset : offset+4])
+		length := int(binary.BigEndian.Uint32(s.Shp[offset+4:offset+8])) * 2
+		offset += 8
+		if offset+length > len(s.Shp) {
+			return nil, fmt.Errorf("invalid .shp file: record %d is truncated", number)
+		}
+		content := s.Shp[offset : offset+length]
+		offset += length
+
+		geometry, err := readShape(content)
+		if err != nil {
+			return nil, fmt.Errorf("invalid .shp file: record %d: %w", number, err)
+		}
+		if geometry == nil {
+			continue
+		}
+
+		feature := Feature{Properties: map[string]any{}, Geometry: geometry}
+		if records != nil {
+			if index >= len(records) {
+				return nil, fmt.Errorf("invalid .dbf file: missing record %d", number)
+			}
+			if records[index] == nil {
+				// Deleted record
+				continue
+			}
+			feature.Properties = records[index]
+		}
+		for _, key := range []string{"name", "NAME", "Name"} {
+			if name, ok := feature.Properties[key].(string); ok && name != "" {
+				feature.Name = name
+				break
+			}
+		}
+
+		features = append(features, feature)
+	}
+
+	return features, nil
+}
+
+// shapeReader decodes the little endian content of a shape record
+type shapeReader struct {
+	content []byte
+	offset  int
+	err     error
+}
+
+func (r *shapeReader) uint32() int {
+	if r.err != nil || r.offset+4 > len(r.content) {
+		r.err = fmt.Errorf("record is truncated")
+		return 0
+	}
+	value := binary.LittleEndian.Uint32(r.content[r.offset:])
+	r.offset += 4
+	return int(value)
+}
+
+func (r *shapeReader) float64() float64 {
+	if r.err != nil || r.offset+8 > len(r.content) {
+		r.err = fmt.Errorf("record is truncated")
+		return 0
+	}
+	value := math.Float64frombits(binary.LittleEndian.Uint64(r.content[r.offset:]))
+	r.offset += 8
+	return value
+}
+
+func (r *shapeReader) skip(bytes int) {
+	r.offset += bytes
+}
+
+// points reads count XY pairs, followed by their Z range and values if hasZ
+func (r *shapeReader) points(count int, hasZ bool) []Position {
+	if r.err == nil && count*16 > len(r.content)-r.offset {
+		r.err = fmt.Errorf("record is truncated")
+	}
+	if r.err != nil {
+		return nil
+	}
+
+	points := make([]Position, count)
+	for i := range points {
+		points[i] = Position{Lon: r.float64(), Lat: r.float64()}
+	}
+	if hasZ {
+		r.skip(16)
+		for i := range points {
+			points[i].Alt = r.float64()
+			points[i].HasAlt = true
+		}
+	}
+	return points
+}
+
+func readShape(content []byte) (*Geometry, error) {
+	r := &shapeReader{content: content}
+
+	shapeType := r.uint32()
+	hasZ := shapeType > shapeDimensionsZ && shapeType < shapeDimensionsM
+	baseType := shapeType % 10
+
+	var geometryType string
+	var coordinates any
+	switch {
+	case shapeType == shapeNull:
+		return nil, nil
+	case shapeType > shapeDimensionsM+shapeMultiPoint:
+		return nil, fmt.Errorf("unsupported shape type %d", shapeType)
+	case baseType == shapePoint:
+		point := Position{Lon: r.float64(), Lat: r.float64()}
+		if hasZ {
+			point.Alt, point.HasAlt = r.float64(), true
+		}
+		geometryType, coordinates = "Point", point
+	case baseType == shapeMultiPoint:
+		r.skip(32)
+		geometryType, coordinates = "MultiPoint", r.points(r.uint32(), hasZ)
+	case baseType == shapePolyLine, baseType == shapePolygon:
+		r.skip(32)
+		partCount, pointCount := r.uint32(), r.uint32()
+		if partCount*4 > len(content) {
+			return nil, fmt.Errorf("record is truncated")
+		}
+		starts := make([]int, partCount)
+		for i := range starts {
+			starts[i] = r.uint32()
+		}
+		points := r.points(pointCount, hasZ)
+		if r.err != nil {
+			return nil, r.err
+		}
+
+		parts := make([][]Position, partCount)
+		for i, start := range starts {
+			end := pointCount
+			if i+1 < len(starts) {
+				end = starts[i+1]
+			}
+			if start < 0 || start > end || end > pointCount {
+				return nil, fmt.Errorf("invalid part %d", i)
+			}
+			parts[i] = points[start:end]
+		}
+
+		if baseType == shapePolyLine {
+			geometryType, coordinates = "MultiLineString", parts
+			if len(parts) == 1 {
+				geometryType, coordinates = "LineString", parts[0]
+			}
+		} else {
+			polygons := shapefilePolygons(parts)
+			geometryType, coordinates = "MultiPolygon", polygons
+			if len(polygons) == 1 {
+				geometryType, coordinates = "Polygon", polygons[0]
+			}
+		}
+	default:
+		return nil, fmt.Errorf("unsupported shape type %d", shapeType)
+	}
+	if r.err != nil {
+		return nil, r.err
+	}
+
+	encoded, err := json.Marshal(coordinates)
+	if err != nil {
+		return nil, err
+	}
+	return &Geometry{Type: geometryType, Coordinates: encoded}, nil
+}
+
+// shapefilePolygons groups the rings of a polygon shape. Shapefile outer rings
+// are clockwise and holes counterclockwise; each hole goes to the outer ring
+// that contains it.
+func shapefilePolygons(rings [][]Position) [][][]Position {
+	var polygons [][][]Position
+	var holes [][]Position
+	for _, ring := range rings {
+		if area, _, _ := ringCentroid(ring); area > 0 {
+			holes = append(holes, ring)
+		} else {
+			polygons = append(polygons, [][]Position{ring})
+		}
+	}
+
+	for _, hole := range holes {
+		assigned := false
+		for i, polygon := range polygons {
+			if len(hole) > 0 && ringContains(polygon[0], hole[0]) {
+				polygons[i] = append(polygons[i], hole)
+				assigned = true
+				break
+			}
+		}
+		if !assigned {
+			// Not inside any outer ring, it was wound the wrong way
+			polygons = append(polygons, [][]Position{hole})
+		}
+	}
+
+	return polygons
+}
+
+// ringContains tests whether a position is inside a ring, by ray casting
+func ringContains(ring []Position, p Position) bool {
+	inside := false
+	for i, j := 0, len(ring)-1; i < len(ring); j, i = i, i+1 {
+		a, b := ring[i], ring[j]
+		if (a.Lat > p.Lat) != (b.Lat > p.Lat) && p.Lon < (b.Lon-a.Lon)*(p.Lat-a.Lat)/(b.Lat-a.Lat)+a.Lon {
+			inside = !inside
+		}
+	}
+	return inside
+}
+
+// readDBF decodes the records of a dBase file. Deleted records are nil.
+func readDBF(data []byte) ([]map[string]any, error) {
+	if len(data) < 32 {
+		return nil, fmt.Errorf("bad header")
+	}
+	count := int(binary.LittleEndian.Uint32(data[4:8]))
+	headerLength := int(binary.LittleEndian.Uint16(data[8:10]))
+	recordLength := int(binary.LittleEndian.Uint16(data[10:12]))
+
+	// The lengths come from the file, they're checked before indexing with them
+	if headerLength < 32 || headerLength > len(data) {
+		return nil, fmt.Errorf("header length %d doesn't fit in the file", headerLength)
+	}
+	if count > 0 && (recordLength < 1 || count > (len(data)-headerLength)/recordLength) {
+		return nil, fmt.Errorf("%d records of %d bytes don't fit in the file", count, recordLength)
+	}
+
+	type field struct {
+		name      string
+		kind      byte
+		length    int
+		precision int
+	}
+	var fields []field
+	for offset := 32; offset+32 <= headerLength && data[offset] != 0x0d; offset += 32 {
+		descriptor := data[offset : offset+32]
+		fields = append(fields, field{
+			name:      strings.TrimRight(string(descriptor[0:11]), "\x00 "),
+			kind:      descriptor[11],
+			length:    int(descriptor[16]),
+			precision: int(descriptor[17]),
+		})
+	}
+
+	records := make([]map[string]any, count)
+	for i := range records {
+		offset := headerLength + i*recordLength
+		if offset+recordLength > len(data) {
+			return nil, fmt.Errorf("record %d is truncated", i+1)
+		}
+		record := data[offset : offset+recordLength]
+		if record[0] == '*' {
+			continue
+		}
+
+		values := map[string]any{}
+		position := 1
+		for _, f := range fields {
+			if position+f.length > len(record) {
+				return nil, fmt.Errorf("record %d is truncated", i+1)
+			}
+			values[f.name] = dbfValue(f.kind, record[position:position+f.length])
+			position += f.length
+		}
+		records[i] = values
+	}
+
+	return records, nil
+}
+
+// dbfValue decodes a field value, blank numbers and logicals are null
+func dbfValue(kind byte, raw []byte) any {
+	text := dbfString(raw)
+
+	switch kind {
+	case 'N', 'F':
+		if text == "" {
+			return nil
+		}
+		value, err := strconv.ParseFloat(text, 64)
+		if err != nil {
+			return text
+		}
+		return value
+	case 'L':
+		switch strings.ToUpper(text) {
+		case "T", "Y":
+			return true
+		case "F", "N":
+			return false
+		}
+		return nil
+	}
+
+	return text
+}
+
+// dbfString trims a text value, decoding it as Latin-1 when it isn't UTF-8
+func dbfString(raw []byte) string {
+	raw = bytes.Trim(raw, " \x00")
+	if utf8.Valid(raw) {
+		return string(raw)
+	}
+
+	runes := make([]rune, len(raw))
+	for i, b := range raw {
+		runes[i] = rune(b)
+	}
+	return string(runes)
+}
+
+var (
+	prjUTMZone       = regexp.MustCompile(`UTM[ _]ZONE[ _](\d+)[ _]?([NS])`)
+	prjArgentinaZone = regexp.MustCompile(`ARGENTINA[ _](\d)`)
+)
+
+// CRSFromPrj recognizes the reference system described by the WKT of a .prj
+// file, among the supported ones
+func CRSFromPrj(prj string) (*CRS, error) {
+	wkt := strings.ToUpper(prj)
+
+	code := 0
+	switch {
+	case !strings.Contains(wkt, "PROJCS") && !strings.Contains(wkt, "PROJCRS"):
+		code = 4326
+	case strings.Contains(wkt, "MERCATOR_AUXILIARY_SPHERE") || strings.Contains(wkt, "PSEUDO-MERCATOR") || strings.Contains(wkt, "PSEUDO_MERCATOR"):
+		code = 3857
+	case prjUTMZone.MatchString(wkt):
+		match := prjUTMZone.FindStringSubmatch(wkt)
+		zone, _ := strconv.Atoi(match[1])
+		south := match[2] == "S"
+		switch {
+		case strings.Contains(wkt, "SIRGAS") && south:
+			code = 31960 + zone
+		case strings.Contains(wkt, "SIRGAS"):
+			code = 31954 + zone
+		case strings.Contains(wkt, "ETRS"):
+			code = 25800 + zone
+		case south:
+			code = 32700 + zone
+		default:
+			code = 32600 + zone
+		}
+	case strings.Contains(wkt, "POSGAR") && prjArgentinaZone.MatchString(wkt):
+		zone, _ := strconv.Atoi(prjArgentinaZone.FindStringSubmatch(wkt)[1])
+		code = 5342 + zone
+	}
+
+	if code == 0 {
+		return nil, fmt.Errorf("unrecognized projection in .prj file")
+	}
+	return LookupCRS(strconv.Itoa(code))
+}
@@ -0,0 +1,328 @@
+package geo
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// ParseWKT decodes a Well-Known Text geometry, e.g. "POINT (30 10)". Z
+// coordinates are kept as altitudes and M values are dropped. An EWKT SRID
+// prefix is accepted only for WGS84 (SRID=4326).
+func ParseWKT(s string) (*Geometry, error) {
+	s = strings.TrimSpace(s)
+	if strings.HasPrefix(strings.ToUpper(s), "SRID=") {
+		srid, rest, ok := strings.Cut(s[len("SRID="):], ";")
+		if !ok {
+			return nil, fmt.Errorf("invalid WKT: missing ';' after SRID")
+		}
+		if strings.TrimSpace(srid) != "4326" {
+			return nil, fmt.Errorf("invalid WKT: SRID %s is not supported, coordinates must be WGS84 (SRID=4326)", srid)
+		}
+		s = rest
+	}
+
+	parser := &wktParser{tokens: tokenizeWKT(s)}
+	geometry, err := parser.geometry()
+	if err != nil {
+		return nil, fmt.Errorf("invalid WKT: %w", err)
+	}
+	if !parser.done() {
+		return nil, fmt.Errorf("invalid WKT: unexpected %q after the geometry", parser.peek())
+	}
+
+	return geometry, nil
+}
+
+func tokenizeWKT(s string) []string {
+	var tokens []string
+	var current strings.Builder
+
+	flush := func() {
+		if current.Len() > 0 {
+			tokens = append(tokens, current.String())
+			current.Reset()
+		}
+	}
+
+	for _, r := range s {
+		switch {
+		case r == '(' || r == ')' || r == ',':
+			flush()
+			tokens = append(tokens, string(r))
+		case unicode.IsSpace(r):
+			flush()
+		default:
+			current.WriteRune(r)
+		}
+	}
+	flush()
+
+	return tokens
+}
+
+type wktParser struct {
+	tokens []string
+	pos    int
+	// dimensions of the geometry being parsed, 2 to 4
+	dimensions int
+	hasZ       bool
+}
+
+func (p *wktParser) done() bool {
+	return p.pos >= len(p.tokens)
+}
+
+func (p *wktParser) peek() string {
+	if p.done() {
+		return ""
+	}
+	return p.tokens[p.pos]
+}
+
+func (p *wktParser) next() string {
+	token := p.peek()
+	p.pos++
+	return token
+}
+
+func (p *wktParser) expect(token string) error {
+	if got := p.next(); got != token {
+		if got == "" {
+			return fmt.Errorf("expected %q, got end of input", token)
+		}
+		return fmt.Errorf("expected %q, got %q", token, got)
+	}
+	return nil
+}
+
+func (p *wktParser) geometry() (*Geometry, error) {
+	keyword := strings.ToUpper(p.next())
+	if keyword == "" {
+		return nil, fmt.Errorf("empty geometry")
+	}
+
+	// Dimension suffixes may be separate tokens ("POINT Z") or attached ("POINTZ")
+	p.dimensions, p.hasZ = 2, false
+	for _, suffix := range []string{"ZM", "Z", "M"} {
+		if trimmed := strings.TrimSuffix(keyword, suffix); trimmed != keyword && isWKTType(trimmed) {
+			keyword = trimmed
+			p.setDimensions(suffix)
+			break
+		}
+	}
+	if modifier := strings.ToUpper(p.peek()); modifier == "Z" || modifier == "M" || modifier == "ZM" {
+		p.next()
+		p.setDimensions(modifier)
+	}
+
+	if !isWKTType(keyword) {
+		return nil, fmt.Errorf("unknown geometry type %q", keyword)
+	}
+
+	geometryType := wktTypes[keyword]
+	if strings.ToUpper(p.peek()) == "EMPTY" {
+		p.next()
+		if geometryType == "GeometryCollection" {
+			return NewGeometryCollection(), nil
+		}
+		return nil, fmt.Errorf("empty %s is not supported by GeoJSON", geometryType)
+	}
+
+	if geometryType == "GeometryCollection" {
+		return p.collection()
+	}
+
+	var coordinates any
+	var err error
+	switch geometryType {
+	case "Point":
+		coordinates, err = p.point()
+	case "MultiPoint":
+		coordinates, err = p.multiPoint()
+	case "LineString":
+		coordinates, err = p.positions()
+	case "MultiLineString":
+		coordinates, err = p.nested(p.positions)
+	case "Polygon":
+		coordinates, err = p.nested(p.positions)
+	case "MultiPolygon":
+		coordinates, err = p.nested(func() (any, error) { return p.nested(p.positions) })
+	}
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", geometryType, err)
+	}
+
+	encoded, err := json.Marshal(coordinates)
+	if err != nil {
+		return nil, err
+	}
+	return &Geometry{Type: geometryType, Coordinates: encoded}, nil
+}
+
+var wktTypes = map[string]string{
+	"POINT":              "Point",
+	"MULTIPOINT":         "MultiPoint",
+	"LINESTRING":         "LineString",
+	"MULTILINESTRING":    "MultiLineString",
+	"POLYGON":            "Polygon",
+	"MULTIPOLYGON":       "MultiPolygon",
+	"GEOMETRYCOLLECTION": "GeometryCollection",
+}
+
+func isWKTType(keyword string) bool {
+	_, ok := wktTypes[keyword]
+	return ok
+}
+
+func (p *wktParser) setDimensions(modifier string) {
+	switch modifier {
+	case "Z":
+		p.dimensions, p.hasZ = 3, true
+	case "M":
+		p.dimensions, p.hasZ = 3, false
+	case "ZM":
+		p.dimensions, p.hasZ = 4, true
+	}
+}
+
+// position parses the numbers of a single position, without parentheses
+func (p *wktParser) position() (Position, error) {
+	var values []float64
+	for !p.done() && p.peek() != "," && p.peek() != ")" {
+		token := p.next()
+		value, err := strconv.ParseFloat(token, 64)
+		if err != nil {
+			return Position{}, fmt.Errorf("invalid number %q", token)
+		}
+		values = append(values, value)
+	}
+
+	// Without a modifier, a third value is taken as Z
+	if p.dimensions == 2 && len(values) == 3 {
+		p.dimensions, p.hasZ = 3, true
+	}
+	if len(values) < 2 || len(values) != p.dimensions {
+		return Position{}, fmt.Errorf("expected %d coordinates per position, got %d", p.dimensions, len(values))
+	}
+
+	position := Position{Lon: values[0], Lat: values[1]}
+	if p.hasZ {
+		position.Alt = values[2]
+		position.HasAlt = true
+	}
+	return position, nil
+}
+
+func (p *wktParser) point() (any, error) {
+	if err := p.expect("("); err != nil {
+		return nil, err
+	}
+	position, err := p.position()
+	if err != nil {
+		return nil, err
+	}
+	return position, p.expect(")")
+}
+
+// positions parses a parenthesized list of positions
+func (p *wktParser) positions() (any, error) {
+	if err := p.expect("("); err != nil {
+		return nil, err
+	}
+
+	var positions []Position
+	for {
+		position, err := p.position()
+		if err != nil {
+			return nil, err
+		}
+		positions = append(positions, position)
+
+		if p.peek() != "," {
+			break
+		}
+		p.next()
+	}
+
+	return positions, p.expect(")")
+}
+
+// multiPoint accepts both "MULTIPOINT ((1 2), (3 4))" and "MULTIPOINT (1 2, 3 4)"
+func (p *wktParser) multiPoint() (any, error) {
+	if err := p.expect("("); err != nil {
+		return nil, err
+	}
+
+	var positions []Position
+	for {
+		var position Position
+		var err error
+		if p.peek() == "(" {
+			p.next()
+			if position, err = p.position(); err == nil {
+				err = p.expect(")")
+			}
+		} else {
+			position, err = p.position()
+		}
+		if err != nil {
+			return nil, err
+		}
+		positions = append(positions, position)
+
+		if p.peek() != "," {
+			break
+		}
+		p.next()
+	}
+
+	return positions, p.expect(")")
+}
+
+// nested parses a parenthesized, comma separated list of items
+func (p *wktParser) nested(item func() (any, error)) (any, error) {
+	if err := p.expect("("); err != nil {
+		return nil, err
+	}
+
+	var items []any
+	for {
+		value, err := item()
+		if err != nil {
+			return nil, err
+		}
+		items = append(items, value)
+
+		if p.peek() != "," {
+			break
+		}
+		p.next()
+	}
+
+	return items, p.expect(")")
+}
+
+func (p *wktParser) collection() (*Geometry, error) {
+	if err := p.expect("("); err != nil {
+		return nil, err
+	}
+
+	var geometries []*Geometry
+	for {
+		geometry, err := p.geometry()
+		if err != nil {
+			return nil, err
+		}
+		geometries = append(geometries, geometry)
+
+		if p.peek() != "," {
+			break
+		}
+		p.next()
+	}
+
+	return NewGeometryCollection(geometries...), p.expect(")")
+}
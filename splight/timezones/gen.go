@@ -0,0 +1,52 @@
+//go:build ignore
+
+// This program generates names.go from the zoneinfo.zip shipped with the Go
+// toolchain, the same data time/tzdata embeds. Run it with go generate after
+// upgrading Go.
+package main
+
+import (
+	"archive/zip"
+	"bytes"
+	"fmt"
+	"go/format"
+	"log"
+	"os"
+	"path/filepath"
+	"runtime"
+	"sort"
+	"strings"
+)
+
+func main() {
+	archive, err := zip.OpenReader(filepath.Join(runtime.GOROOT(), "lib", "time", "zoneinfo.zip"))
+	if err != nil {
+		log.Fatal(err)
+	}
+	defer archive.Close()
+
+	var names []string
+	for _, file := range archive.File {
+		if !strings.HasSuffix(file.Name, "/") {
+			names = append(names, file.Name)
+		}
+	}
+	sort.Strings(names)
+
+	var buffer bytes.Buffer
+	fmt.Fprintf(&buffer, "// Code generated by gen.go from the %s tzdata; DO NOT EDIT.\n\n", runtime.Version())
+	fmt.Fprintf(&buffer, "package timezones\n\n")
+	fmt.Fprintf(&buffer, "var names = []string{\n")
+	for _, name := range names {
+		fmt.Fprintf(&buffer, "\t%q,\n", name)
+	}
+	fmt.Fprintf(&buffer, "}\n")
+
+	source, err := format.Source(buffer.Bytes())
+	if err != nil {
+		log.Fatal(err)
+	}
+	if err := os.WriteFile("names.go", source, 0o644); err != nil {
+		log.Fatal(err)
+	}
+}
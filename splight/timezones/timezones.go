@@ -0,0 +1,127 @@
+// Package timezones validates timezone names against the IANA database
+// embedded in the provider binary.
+package timezones
+
+//go:generate go run gen.go
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+	_ "time/tzdata"
+)
+
+// maxSuggestions is the number of close names suggested for an invalid one
+const maxSuggestions = 3
+
+var nameSet = func() map[string]bool {
+	set := make(map[string]bool, len(names))
+	for _, name := range names {
+		set[name] = true
+	}
+	return set
+}()
+
+// Names returns every timezone in the IANA database, including the
+// backward compatible links such as "America/Buenos_Aires"
+func Names() []string {
+	return append([]string{}, names...)
+}
+
+// Validate checks that name is a timezone of the IANA database. The error
+// suggests the closest names when it isn't.
+func Validate(name string) error {
+	if nameSet[name] {
+		if _, err := time.LoadLocation(name); err == nil {
+			return nil
+		}
+	}
+
+	message := fmt.Sprintf("%q is not a timezone of the IANA database", name)
+	if suggestions := Suggest(name); len(suggestions) > 0 {
+		quoted := make([]string, len(suggestions))
+		for i, suggestion := range suggestions {
+			quoted[i] = fmt.Sprintf("%q", suggestion)
+		}
+		message += fmt.Sprintf(", did you mean %s?", strings.Join(quoted, " or "))
+	}
+	return fmt.Errorf("%s", message)
+}
+
+// Suggest returns the timezones closest to a misspelled name: the ones that
+// only differ in case or separators, otherwise the ones within a small edit
+// distance or sharing its city
+func Suggest(name string) []string {
+	key := normalizeName(name)
+	if key == "" {
+		return nil
+	}
+
+	type candidate struct {
+		name     string
+		distance int
+	}
+	var candidates []candidate
+
+	maxDistance := max(1, len(key)/4)
+	for _, zone := range names {
+		zoneKey := normalizeName(zone)
+		if zoneKey == key {
+			return []string{zone}
+		}
+
+		distance := levenshtein(key, zoneKey)
+		if !strings.Contains(key, "/") {
+			// A bare city matches the last element of the zone
+			city := zoneKey[strings.LastIndex(zoneKey, "/")+1:]
+			distance = min(distance, levenshtein(key, city))
+		}
+		if distance <= maxDistance {
+			candidates = append(candidates, candidate{zone, distance})
+		}
+	}
+
+	sort.SliceStable(candidates, func(i, j int) bool {
+		return candidates[i].distance < candidates[j].distance
+	})
+
+	suggestions := make([]string, 0, maxSuggestions)
+	for _, c := range candidates {
+		if len(suggestions) == maxSuggestions {
+			break
+		}
+		suggestions = append(suggestions, c.name)
+	}
+	return suggestions
+}
+
+// normalizeName normalizes case and separators, "america/buenos aires" and
+// "America/Buenos_Aires" compare equal
+func normalizeName(name string) string {
+	name = strings.ToLower(strings.TrimSpace(name))
+	return strings.NewReplacer(" ", "_", "-", "_").Replace(name)
+}
+
+// levenshtein returns the edit distance between two strings
+func levenshtein(a, b string) int {
+	previous := make([]int, len(b)+1)
+	current := make([]int, len(b)+1)
+	for j := range previous {
+		previous[j] = j
+	}
+
+	for i := 1; i <= len(a); i++ {
+		current[0] = i
+		for j := 1; j <= len(b); j++ {
+			cost := 1
+			if a[i-1] == b[j-1] {
+				cost = 0
+			}
+			current[j] = min(previous[j]+1, current[j-1]+1, previous[j-1]+cost)
+		}
+		previous, current = current, previous
+	}
+
+	return previous[len(b)]
+}
@@ -0,0 +1,297 @@
+package units
+
+import (
+	"fmt"
+	"math"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// Unit is a unit of measure. Values are converted to the coherent SI unit of
+// their dimension as value*Factor + Offset.
+type Unit struct {
+	Symbol    string
+	Dimension string
+	Factor    float64
+	Offset    float64
+}
+
+// catalog holds the known units by symbol
+var catalog = map[string]Unit{}
+
+// aliases maps alternative spellings to the symbol of a unit
+var aliases = map[string]string{
+	"meter":      "m",
+	"meters":     "m",
+	"metre":      "m",
+	"metres":     "m",
+	"kilometers": "km",
+	"kilometres": "km",
+	"ohm":        "Ω",
+	"ohms":       "Ω",
+	"Ohm":        "Ω",
+	"Ω":          "Ω",
+	"mohm":       "mΩ",
+	"kohm":       "kΩ",
+	"ohm/km":     "Ω/km",
+	"ohm/m":      "Ω/m",
+	"ohm/mi":     "Ω/mi",
+	"degC":       "°C",
+	"degF":       "°F",
+	"F/m":        "F/m",
+	"deg":        "°",
+	"degree":     "°",
+	"degrees":    "°",
+	"uF":         "µF",
+	"μF":         "µF",
+	"uF/km":      "µF/km",
+	"μF/km":      "µF/km",
+	"uS":         "µS",
+	"μS":         "µS",
+	"uS/km":      "µS/km",
+	"μS/km":      "µS/km",
+	"MVAr":       "Mvar",
+	"MVAR":       "Mvar",
+	"kVAr":       "kvar",
+	"kVAR":       "kvar",
+	"VAr":        "var",
+	"kph":        "km/h",
+	"1/degC":     "1/°C",
+	"1/C":        "1/°C",
+	"J/(kg*K)":   "J/(kg·K)",
+	"J/(kg*°C)":  "J/(kg·°C)",
+	"J/kgK":      "J/(kg·K)",
+	"W/m2":       "W/m²",
+}
+
+func register(dimension string, units ...Unit) {
+	for _, unit := range units {
+		unit.Dimension = dimension
+		catalog[unit.Symbol] = unit
+	}
+}
+
+func init() {
+	register("dimensionless",
+		Unit{Symbol: "1", Factor: 1},
+		Unit{Symbol: "pu", Factor: 1},
+		Unit{Symbol: "%", Factor: 0.01},
+	)
+	register("length",
+		Unit{Symbol: "m", Factor: 1},
+		Unit{Symbol: "km", Factor: 1e3},
+		Unit{Symbol: "cm", Factor: 1e-2},
+		Unit{Symbol: "mm", Factor: 1e-3},
+		Unit{Symbol: "mi", Factor: 1609.344},
+		Unit{Symbol: "ft", Factor: 0.3048},
+		Unit{Symbol: "in", Factor: 0.0254},
+	)
+	register("mass",
+		Unit{Symbol: "kg", Factor: 1},
+		Unit{Symbol: "g", Factor: 1e-3},
+		Unit{Symbol: "t", Factor: 1e3},
+		Unit{Symbol: "lb", Factor: 0.45359237},
+	)
+	register("time",
+		Unit{Symbol: "s", Factor: 1},
+		Unit{Symbol: "ms", Factor: 1e-3},
+		Unit{Symbol: "min", Factor: 60},
+		Unit{Symbol: "h", Factor: 3600},
+		Unit{Symbol: "d", Factor: 86400},
+	)
+	register("temperature",
+		Unit{Symbol: "K", Factor: 1},
+		Unit{Symbol: "°C", Factor: 1, Offset: 273.15},
+		Unit{Symbol: "°F", Factor: 5.0 / 9, Offset: 273.15 - 32*5.0/9},
+	)
+	register("inverse temperature",
+		Unit{Symbol: "1/K", Factor: 1},
+		Unit{Symbol: "1/°C", Factor: 1},
+		Unit{Symbol: "1/°F", Factor: 9.0 / 5},
+	)
+	register("angle",
+		Unit{Symbol: "rad", Factor: 1},
+		Unit{Symbol: "°", Factor: math.Pi / 180},
+	)
+	register("frequency",
+		Unit{Symbol: "Hz", Factor: 1},
+		Unit{Symbol: "kHz", Factor: 1e3},
+	)
+	register("speed",
+		Unit{Symbol: "m/s", Factor: 1},
+		Unit{Symbol: "km/h", Factor: 1 / 3.6},
+		Unit{Symbol: "mph", Factor: 0.44704},
+		Unit{Symbol: "kn", Factor: 1852.0 / 3600},
+	)
+	register("pressure",
+		Unit{Symbol: "Pa", Factor: 1},
+		Unit{Symbol: "kPa", Factor: 1e3},
+		Unit{Symbol: "bar", Factor: 1e5},
+		Unit{Symbol: "hPa", Factor: 1e2},
+	)
+	register("irradiance",
+		Unit{Symbol: "W/m²", Factor: 1},
+		Unit{Symbol: "kW/m²", Factor: 1e3},
+	)
+	register("current",
+		Unit{Symbol: "A", Factor: 1},
+		Unit{Symbol: "mA", Factor: 1e-3},
+		Unit{Symbol: "kA", Factor: 1e3},
+	)
+	register("voltage",
+		Unit{Symbol: "V", Factor: 1},
+		Unit{Symbol: "mV", Factor: 1e-3},
+		Unit{Symbol: "kV", Factor: 1e3},
+		Unit{Symbol: "MV", Factor: 1e6},
+	)
+	register("active power",
+		Unit{Symbol: "W", Factor: 1},
+		Unit{Symbol: "kW", Factor: 1e3},
+		Unit{Symbol: "MW", Factor: 1e6},
+		Unit{Symbol: "GW", Factor: 1e9},
+	)
+	register("apparent power",
+		Unit{Symbol: "VA", Factor: 1},
+		Unit{Symbol: "kVA", Factor: 1e3},
+		Unit{Symbol: "MVA", Factor: 1e6},
+		Unit{Symbol: "GVA", Factor: 1e9},
+	)
+	register("reactive power",
+		Unit{Symbol: "var", Factor: 1},
+		Unit{Symbol: "kvar", Factor: 1e3},
+		Unit{Symbol: "Mvar", Factor: 1e6},
+	)
+	register("energy",
+		Unit{Symbol: "J", Factor: 1},
+		Unit{Symbol: "kJ", Factor: 1e3},
+		Unit{Symbol: "MJ", Factor: 1e6},
+		Unit{Symbol: "Wh", Factor: 3600},
+		Unit{Symbol: "kWh", Factor: 3.6e6},
+		Unit{Symbol: "MWh", Factor: 3.6e9},
+		Unit{Symbol: "GWh", Factor: 3.6e12},
+	)
+	register("resistance",
+		Unit{Symbol: "Ω", Factor: 1},
+		Unit{Symbol: "mΩ", Factor: 1e-3},
+		Unit{Symbol: "kΩ", Factor: 1e3},
+	)
+	register("conductance",
+		Unit{Symbol: "S", Factor: 1},
+		Unit{Symbol: "mS", Factor: 1e-3},
+		Unit{Symbol: "µS", Factor: 1e-6},
+	)
+	register("capacitance",
+		Unit{Symbol: "F", Factor: 1},
+		Unit{Symbol: "µF", Factor: 1e-6},
+		Unit{Symbol: "nF", Factor: 1e-9},
+		Unit{Symbol: "pF", Factor: 1e-12},
+	)
+	register("inductance",
+		Unit{Symbol: "H", Factor: 1},
+		Unit{Symbol: "mH", Factor: 1e-3},
+	)
+	register("resistance per length",
+		Unit{Symbol: "Ω/m", Factor: 1},
+		Unit{Symbol: "Ω/km", Factor: 1e-3},
+		Unit{Symbol: "Ω/mi", Factor: 1 / 1609.344},
+	)
+	register("conductance per length",
+		Unit{Symbol: "S/m", Factor: 1},
+		Unit{Symbol: "S/km", Factor: 1e-3},
+		Unit{Symbol: "µS/km", Factor: 1e-9},
+	)
+	register("capacitance per length",
+		Unit{Symbol: "F/m", Factor: 1},
+		Unit{Symbol: "F/km", Factor: 1e-3},
+		Unit{Symbol: "µF/km", Factor: 1e-9},
+		Unit{Symbol: "nF/km", Factor: 1e-12},
+	)
+	register("linear density",
+		Unit{Symbol: "kg/m", Factor: 1},
+		Unit{Symbol: "kg/km", Factor: 1e-3},
+	)
+	register("specific heat",
+		Unit{Symbol: "J/(kg·K)", Factor: 1},
+		Unit{Symbol: "J/(kg·°C)", Factor: 1},
+	)
+}
+
+// Symbols returns the symbols of the known units, sorted
+func Symbols() []string {
+	symbols := make([]string, 0, len(catalog))
+	for symbol := range catalog {
+		symbols = append(symbols, symbol)
+	}
+	slices.Sort(symbols)
+	return symbols
+}
+
+// Lookup returns a unit by its symbol or one of its aliases
+func Lookup(symbol string) (Unit, error) {
+	symbol = strings.TrimSpace(symbol)
+	if alias, ok := aliases[symbol]; ok {
+		symbol = alias
+	}
+
+	unit, ok := catalog[symbol]
+	if !ok {
+		return Unit{}, fmt.Errorf("unknown unit %q", symbol)
+	}
+	return unit, nil
+}
+
+// Convert converts a value between two units of the same dimension, rounded
+// to 12 significant digits
+func Convert(value float64, from, to string) (float64, error) {
+	fromUnit, err := Lookup(from)
+	if err != nil {
+		return 0, err
+	}
+	toUnit, err := Lookup(to)
+	if err != nil {
+		return 0, err
+	}
+
+	if fromUnit.Dimension != toUnit.Dimension {
+		return 0, fmt.Errorf(
+			"cannot convert %s (%s) to %s (%s)",
+			fromUnit.Symbol, fromUnit.Dimension, toUnit.Symbol, toUnit.Dimension,
+		)
+	}
+
+	si := value*fromUnit.Factor + fromUnit.Offset
+	converted := (si - toUnit.Offset) / toUnit.Factor
+
+	// Drop the floating point noise of the factors, e.g. 12.299999999999999
+	return strconv.ParseFloat(strconv.FormatFloat(converted, 'g', 12, 64), 64)
+}
+
+var quantityRegexp = regexp.MustCompile(`^\s*([-+]?(?:\d+\.?\d*|\.\d+)(?:[eE][-+]?\d+)?)(?:\s+(\S.*?)|([^\s\d.].*?))\s*$`)
+
+// ParseQuantity parses a value followed by its unit, e.g. "12.3 km". It
+// returns the value and the unit, with aliases resolved to their symbol.
+func ParseQuantity(s string) (float64, Unit, error) {
+	match := quantityRegexp.FindStringSubmatch(s)
+	if match == nil {
+		return 0, Unit{}, fmt.Errorf("quantity %q must be a number followed by a unit, e.g. \"12.3 km\"", s)
+	}
+
+	value, err := strconv.ParseFloat(match[1], 64)
+	if err != nil {
+		return 0, Unit{}, fmt.Errorf("invalid quantity %q: %w", s, err)
+	}
+
+	symbol := match[2]
+	if symbol == "" {
+		symbol = match[3]
+	}
+
+	unit, err := Lookup(symbol)
+	if err != nil {
+		return 0, Unit{}, err
+	}
+
+	return value, unit, nil
+}